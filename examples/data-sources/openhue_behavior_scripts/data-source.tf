@@ -0,0 +1,8 @@
+data "openhue_behavior_scripts" "all" {}
+
+# The configuration schema of the wake up script, to build an openhue_behavior_instance from
+output "wake_up_schema" {
+  value = jsondecode(one([
+    for script in data.openhue_behavior_scripts.all.scripts : script.configuration_schema if script.name == "Wake up"
+  ]))
+}
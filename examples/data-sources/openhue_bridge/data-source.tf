@@ -0,0 +1,10 @@
+data "openhue_bridge" "this" {}
+
+output "bridge" {
+  value = {
+    id         = data.openhue_bridge.this.bridge_id
+    ip_address = data.openhue_bridge.this.ip_address
+    ip_source  = data.openhue_bridge.this.ip_source
+    version    = data.openhue_bridge.this.software_version
+  }
+}
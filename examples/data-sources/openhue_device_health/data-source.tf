@@ -0,0 +1,20 @@
+data "openhue_device_health" "low_battery" {
+  battery_level_below = 20
+}
+
+data "openhue_device_health" "unreachable_lights" {
+  service_type     = "light"
+  unreachable_only = true
+}
+
+check "device_health" {
+  assert {
+    condition     = length(data.openhue_device_health.low_battery.devices) == 0
+    error_message = "Batteries running low: ${join(", ", data.openhue_device_health.low_battery.devices[*].name)}"
+  }
+
+  assert {
+    condition     = length(data.openhue_device_health.unreachable_lights.devices) == 0
+    error_message = "Unreachable lights: ${join(", ", data.openhue_device_health.unreachable_lights.devices[*].name)}"
+  }
+}
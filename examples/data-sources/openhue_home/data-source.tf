@@ -0,0 +1,11 @@
+data "openhue_home" "home" {}
+
+# A summary of every room with its lights and scenes, for example to render a dashboard
+output "rooms" {
+  value = {
+    for room in data.openhue_home.home.rooms : room.name => {
+      lights = length(room.light_ids)
+      scenes = [for scene in room.scenes : scene.name]
+    }
+  }
+}
@@ -1,3 +1,13 @@
 data "openhue_light" "light_2" {
   name = "lamp_2" // The name of the light to be retrieved
-}
\ No newline at end of file
+}
+
+data "openhue_light" "kitchen_lamp" {
+  name = "Lamp"    // Several lights share this name...
+  room = "Kitchen" // ...so pick the one in the kitchen
+}
+
+data "openhue_light" "desk" {
+  name  = "^desk"
+  match = "regex"
+}
@@ -0,0 +1,9 @@
+# A scene created in the Hue app
+data "openhue_scene" "relax" {
+  name     = "Relax"
+  group_id = "aaaa-bbbb-cccc-ddd" # Several rooms have a "Relax" scene
+}
+
+resource "openhue_scene_activation" "relax" {
+  scene_id = data.openhue_scene.relax.id
+}
@@ -0,0 +1,12 @@
+resource "openhue_room" "living_room" {
+  name      = "Living room"
+  archetype = "other"
+}
+
+data "openhue_scenes" "living_room" {
+  group_id = openhue_room.living_room.id
+}
+
+output "living_room_scenes" {
+  value = { for scene in data.openhue_scenes.living_room.scenes : scene.name => scene.id }
+}
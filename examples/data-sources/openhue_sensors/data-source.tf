@@ -0,0 +1,15 @@
+data "openhue_sensors" "garden" {
+  device_name = "Garden sensor" # Optional, omit to return every sensor
+}
+
+locals {
+  garden_lux = one(data.openhue_sensors.garden.light_level[*].lux)
+}
+
+output "garden_is_dark" {
+  value = local.garden_lux < 50
+}
+
+output "garden_temperature" {
+  value = one(data.openhue_sensors.garden.temperature[*].temperature)
+}
@@ -0,0 +1,2 @@
+# Behavior instances are imported using their ID
+terraform import openhue_behavior_instance.wake_up aaaa-bbbb-cccc-ddd
@@ -0,0 +1,41 @@
+data "openhue_light" "bedside" {
+  name = "Bedside"
+}
+
+# Fade the bedroom in before the alarm on weekdays
+resource "openhue_behavior_instance" "wake_up" {
+  name        = "Weekday wake up"
+  script_name = "Wake up"
+
+  configuration = jsonencode({
+    end_brightness = 100
+
+    fade_in_duration = {
+      seconds = 1800
+    }
+    when = {
+      recurrence_days = ["monday", "tuesday", "wednesday", "thursday", "friday"]
+      time_point = {
+        type = "time"
+        time = {
+          hour   = 7
+          minute = 0
+        }
+      }
+    }
+    where = [
+      {
+        group = {
+          rid   = "d1b3c4e5-0000-0000-0000-000000000000"
+          rtype = "room"
+        }
+        items = [
+          {
+            rid   = data.openhue_light.bedside.id
+            rtype = "light"
+          }
+        ]
+      }
+    ]
+  })
+}
@@ -0,0 +1,2 @@
+# CLIP sensors are imported using their v1 ID
+terraform import openhue_clip_sensor.armed 5
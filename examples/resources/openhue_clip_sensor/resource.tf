@@ -0,0 +1,26 @@
+# A flag that the alarm system sets when the house is armed
+resource "openhue_clip_sensor" "armed" {
+  name = "Alarm armed"
+  type = "CLIPGenericFlag"
+}
+
+# Turn everything off once the flag is set
+resource "openhue_rule" "armed_all_off" {
+  name = "Armed all off"
+
+  condition {
+    address  = openhue_clip_sensor.armed.address
+    operator = "eq"
+    value    = "true"
+  }
+
+  condition {
+    address  = openhue_clip_sensor.armed.address
+    operator = "dx"
+  }
+
+  action {
+    address = "/groups/0/action"
+    body    = jsonencode({ on = false })
+  }
+}
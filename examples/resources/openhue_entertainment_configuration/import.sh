@@ -0,0 +1,2 @@
+# Entertainment configurations are imported using their ID
+terraform import openhue_entertainment_configuration.tv aaaa-bbbb-cccc-ddd
@@ -0,0 +1,36 @@
+data "openhue_light" "tv_left" {
+  name = "TV left"
+}
+
+data "openhue_light" "tv_right" {
+  name = "TV right"
+}
+
+data "openhue_light" "tv_strip" {
+  name = "TV gradient strip"
+}
+
+resource "openhue_entertainment_configuration" "tv" {
+  name               = "TV area"
+  configuration_type = "screen"
+
+  lights = [
+    {
+      light_id  = data.openhue_light.tv_left.id
+      positions = [{ x = -0.8, y = 0.6, z = 0 }]
+    },
+    {
+      light_id  = data.openhue_light.tv_right.id
+      positions = [{ x = 0.8, y = 0.6, z = 0 }]
+    },
+    {
+      # A gradient strip behind the screen takes one position per segment
+      light_id = data.openhue_light.tv_strip.id
+      positions = [
+        { x = -0.4, y = 0.8, z = 0.2 },
+        { x = 0, y = 0.8, z = 0.4 },
+        { x = 0.4, y = 0.8, z = 0.2 },
+      ]
+    },
+  ]
+}
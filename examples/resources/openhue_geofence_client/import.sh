@@ -0,0 +1,2 @@
+# Geofence clients are imported using their ID
+terraform import openhue_geofence_client.alex_phone aaaa-bbbb-cccc-ddd
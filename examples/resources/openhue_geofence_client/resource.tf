@@ -0,0 +1,4 @@
+resource "openhue_geofence_client" "alex_phone" {
+  name       = "Alex's phone"
+  is_at_home = true
+}
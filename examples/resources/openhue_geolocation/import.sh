@@ -0,0 +1,2 @@
+# The geolocation is imported using its ID. The coordinates are written again on the next apply.
+terraform import openhue_geolocation.home aaaa-bbbb-cccc-ddd
@@ -0,0 +1,5 @@
+# Sunrise and sunset automations use the location of the bridge
+resource "openhue_geolocation" "home" {
+  latitude  = 52.3676
+  longitude = 4.9041
+}
@@ -5,4 +5,58 @@ resource "openhue_light" "light_1" {
   on         = true
   brightness = 100
   color      = provider::openhue::hextod65("#0000ff") # Blue
+
+  # Fail the apply instead of skipping the lamp when it is switched off at the wall
+  unreachable_behavior = "error"
+}
+
+resource "openhue_light" "fireplace" {
+  name   = "fireplace"
+  on     = true
+  effect = "fire" # Must be one of the effects the light supports
+}
+
+resource "openhue_light" "bedside" {
+  name = "bedside"
+  on   = true
+
+  # Wake up with a 30 minute sunrise
+  timed_effect = {
+    name     = "sunrise"
+    duration = 1800000
+  }
+}
+
+resource "openhue_light" "tv_strip" {
+  name = "tv_strip"
+  on   = true
+
+  # Only available on gradient capable lights
+  gradient = {
+    mode   = "interpolated_palette"
+    points = ["#ff0000", "#ff8800", "#ffff00", "#00ff00", "#0000ff"]
+  }
+}
+
+resource "openhue_light" "hallway" {
+  name = "hallway"
+  on   = true
+
+  # Come back on dimmed and warm after a power cut instead of full white
+  powerup = {
+    preset            = "custom"
+    on_mode           = "on"
+    dimming_mode      = "dimming"
+    brightness        = 30
+    color_mode        = "color_temperature"
+    color_temperature = 454
+  }
+}
+
+resource "openhue_light" "bedroom_lamp" {
+  # Several lights are called "lamp", so pick the one in the bedroom
+  name  = "lamp"
+  room  = "bedroom"
+  match = "case_insensitive"
+  on    = true
 }
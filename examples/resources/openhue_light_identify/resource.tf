@@ -0,0 +1,15 @@
+resource "openhue_light" "desk" {
+  name = "desk"
+  on   = true
+}
+
+# Flash the desk lamp to check which physical lamp it is
+resource "openhue_light_identify" "desk" {
+  light_id = openhue_light.desk.id
+  action   = "breathe"
+
+  # Change any value to identify the light again
+  triggers = {
+    commissioned = "2024-10-18"
+  }
+}
@@ -0,0 +1,2 @@
+# Motion sensors are imported using the ID of their motion service
+terraform import openhue_motion_sensor.hallway aaaa-bbbb-cccc-ddd
@@ -0,0 +1,12 @@
+resource "openhue_motion_sensor" "hallway" {
+  # Adopt the motion sensor on the device with this name
+  device_name = "Hallway sensor"
+  enabled     = true
+  sensitivity = 2
+}
+
+resource "openhue_motion_sensor" "garden" {
+  # Or adopt the motion service directly by ID
+  id      = "aaaa-bbbb-cccc-ddd"
+  enabled = false
+}
@@ -0,0 +1,2 @@
+# Rooms are imported using the ID of the room
+terraform import openhue_room.bedroom aaaa-bbbb-cccc-ddd
@@ -0,0 +1,2 @@
+# Rules are imported using their v1 ID
+terraform import openhue_rule.hallway_on_flag 12
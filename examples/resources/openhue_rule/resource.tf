@@ -0,0 +1,31 @@
+# Turn the hallway on when the CLIP flag sensor 5 is set, but only in the evening
+resource "openhue_rule" "hallway_on_flag" {
+  name = "Hallway on flag"
+
+  condition {
+    address  = "/sensors/5/state/flag"
+    operator = "eq"
+    value    = "true"
+  }
+
+  condition {
+    address  = "/sensors/5/state/lastupdated"
+    operator = "dx"
+  }
+
+  condition {
+    address  = "/config/localtime"
+    operator = "in"
+    value    = "T18:00:00/T23:00:00"
+  }
+
+  action {
+    address = "/groups/2/action"
+    body    = jsonencode({ on = true, bri = 200 })
+  }
+
+  action {
+    address = "/sensors/5/state"
+    body    = jsonencode({ flag = false })
+  }
+}
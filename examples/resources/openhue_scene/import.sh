@@ -0,0 +1,2 @@
+# Scenes are imported using their ID. The palette is only managed once it is added to the configuration.
+terraform import openhue_scene.sunset aaaa-bbbb-cccc-ddd
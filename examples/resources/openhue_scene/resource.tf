@@ -0,0 +1,30 @@
+resource "openhue_room" "living_room" {
+  name      = "Living room"
+  archetype = "other"
+  lights    = ["aaaa-bbbb-cccc-ddd"]
+}
+
+resource "openhue_scene" "sunset" {
+  name     = "Sunset"
+  group_id = openhue_room.living_room.id
+
+  actions = [
+    {
+      target_id  = "1111-2222-3333-444" # The ID of a light in the room
+      on         = true
+      brightness = 80
+      color      = "#ff8800"
+    },
+  ]
+
+  # Play the palette dynamically whenever the scene is recalled
+  palette = {
+    colors = [
+      { color = "#ff4400", brightness = 80 },
+      { color = "#ff8800", brightness = 70 },
+      { color = "#ffcc00" },
+    ]
+  }
+  speed        = 0.4
+  auto_dynamic = true
+}
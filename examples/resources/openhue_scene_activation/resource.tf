@@ -0,0 +1,16 @@
+resource "openhue_light" "lamp_1" {
+  name = "lamp_1"
+  on   = true
+}
+
+# Put the living room back into its evening scene after every change to the lamp
+resource "openhue_scene_activation" "evening" {
+  scene_id   = "aaaa-bbbb-cccc-ddd"
+  action     = "active"
+  duration   = 2000 # Fade in over two seconds
+  brightness = 60
+
+  triggers = {
+    lamp = jsonencode(openhue_light.lamp_1)
+  }
+}
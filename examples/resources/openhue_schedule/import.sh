@@ -0,0 +1,2 @@
+# Schedules are imported using their v1 ID
+terraform import openhue_schedule.living_room_off 1
@@ -0,0 +1,16 @@
+# Turn the living room off at 23:30 on weekdays (Monday to Friday: 64+32+16+8+4 = 124)
+resource "openhue_schedule" "living_room_off" {
+  name            = "Living room off"
+  command_address = "/groups/1/action"
+  command_body    = jsonencode({ on = false })
+  localtime       = "W124/T23:30:00"
+}
+
+# Turn the porch light on at a random time between 18:00 and 18:30 every day
+resource "openhue_schedule" "porch_on" {
+  name            = "Porch on"
+  description     = "Make the house look occupied"
+  command_address = "/lights/3/state"
+  command_body    = jsonencode({ on = true, bri = 254 })
+  localtime       = "W127/T18:00:00A00:30:00"
+}
@@ -0,0 +1,2 @@
+# Smart scenes are imported using their ID
+terraform import openhue_smart_scene.natural_light aaaa-bbbb-cccc-ddd
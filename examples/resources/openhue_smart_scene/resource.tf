@@ -0,0 +1,31 @@
+resource "openhue_room" "living_room" {
+  name      = "Living room"
+  archetype = "other"
+}
+
+resource "openhue_smart_scene" "natural_light" {
+  name     = "Natural light"
+  group_id = openhue_room.living_room.id
+
+  week_timeslots = [
+    {
+      recurrence = ["monday", "tuesday", "wednesday", "thursday", "friday"]
+      timeslots = [
+        { start_time = "07:00", scene_id = "energize-scene-id" },
+        { start_time = "12:00", scene_id = "concentrate-scene-id" },
+        { start_kind = "sunset", scene_id = "relax-scene-id" },
+        { start_time = "22:30", scene_id = "nightlight-scene-id" },
+      ]
+    },
+    {
+      recurrence = ["saturday", "sunday"]
+      timeslots = [
+        { start_time = "09:00", scene_id = "bright-scene-id" },
+        { start_kind = "sunset", scene_id = "relax-scene-id" },
+      ]
+    },
+  ]
+
+  transition_duration = 60000 # One minute
+  active              = true
+}
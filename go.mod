@@ -7,6 +7,7 @@ toolchain go1.22.11
 require (
 	github.com/davecgh/go-spew v1.1.2-0.20180830191138-d8f796af33cc
 	github.com/hashicorp/terraform-plugin-framework v1.13.0
+	github.com/hashicorp/terraform-plugin-framework-validators v0.16.0
 	github.com/hashicorp/terraform-plugin-log v0.9.0
 	github.com/lucasb-eyer/go-colorful v1.2.0
 	github.com/openhue/openhue-go v0.3.0
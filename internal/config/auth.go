@@ -14,9 +14,18 @@ import (
 
 const cachePath = ".openhue-credentials.json"
 
+// Where the bridge IP used by the provider was resolved from
+const (
+	BridgeIpSourceProvider    = "provider"
+	BridgeIpSourceEnvironment = "environment"
+	BridgeIpSourceCache       = "cache"
+	BridgeIpSourceDiscovery   = "discovery"
+)
+
 type AuthConfig struct {
-	BridgeIp     string
-	BridgeApiKey string
+	BridgeIp       string
+	BridgeIpSource string
+	BridgeApiKey   string
 }
 
 // GetAuthConfig returns the Hue bridge IP and API key to use for the provider
@@ -32,8 +41,8 @@ func GetAuthConfig(ctx context.Context, tfBridgeIp string, tfBridgeApiKey string
 
 	cfg.SetConfigName("credentials")
 	cfg.SetConfigType("json")
-	viper.SetDefault("hue_bridge_ip", tfBridgeIp)
-	viper.SetDefault("hue_bridge_api_key", tfBridgeApiKey)
+	cfg.SetDefault("hue_bridge_ip", tfBridgeIp)
+	cfg.SetDefault("hue_bridge_api_key", tfBridgeApiKey)
 
 	if useCache {
 		tflog.Info(ctx, "Using cache for Hue bridge credentials")
@@ -42,9 +51,16 @@ func GetAuthConfig(ctx context.Context, tfBridgeIp string, tfBridgeApiKey string
 	cfg.AutomaticEnv()
 	cfg.ReadInConfig()
 
+	bridgeIpSource := BridgeIpSourceProvider
+	if os.Getenv("HUE_BRIDGE_IP") != "" {
+		bridgeIpSource = BridgeIpSourceEnvironment
+	} else if cfg.InConfig("hue_bridge_ip") {
+		bridgeIpSource = BridgeIpSourceCache
+	}
+
 	// Fallback to discovery and authentication
 	hueBridgeIp := cfg.GetString("hue_bridge_ip")
-	if hueBridgeIp == "" || tfBridgeIp == "discover" {
+	if hueBridgeIp == "" || hueBridgeIp == "discover" || tfBridgeIp == "discover" {
 		tflog.Info(ctx, "No Hue bridge ip provided ot set to always discover, attempting to discover one on the local network")
 
 		discoveryMetadata, err := openhue.NewBridgeDiscovery(
@@ -60,6 +76,7 @@ func GetAuthConfig(ctx context.Context, tfBridgeIp string, tfBridgeApiKey string
 		tflog.Info(ctx, "Successfully discovered Hue Bridge")
 
 		cfg.Set("hue_bridge_ip", discoveryMetadata.IpAddress)
+		bridgeIpSource = BridgeIpSourceDiscovery
 	}
 
 	hueBridgeApiKey := cfg.GetString("hue_bridge_api_key")
@@ -82,8 +99,9 @@ func GetAuthConfig(ctx context.Context, tfBridgeIp string, tfBridgeApiKey string
 	}
 
 	return &AuthConfig{
-		BridgeIp:     cfg.GetString("hue_bridge_ip"),
-		BridgeApiKey: cfg.GetString("hue_bridge_api_key"),
+		BridgeIp:       cfg.GetString("hue_bridge_ip"),
+		BridgeIpSource: bridgeIpSource,
+		BridgeApiKey:   cfg.GetString("hue_bridge_api_key"),
 	}, nil
 }
 
@@ -106,7 +124,8 @@ func authenticateWithBridgeIp(ctx context.Context, bridgeIpAddress string) (stri
 		case <-timeoutCtx.Done():
 			return "", fmt.Errorf("timed out waiting for Hue Bridge to be authenticated")
 		case <-ticker.C:
-			fmt.Println("Waiting for hue bridge button to be pressed")
+			// Progress goes to stderr, stdout carries the plugin handshake and exported configuration
+			fmt.Fprintln(os.Stderr, "Waiting for hue bridge button to be pressed")
 			apiKey, err = authenticateWithBridgeIpOnce(ctx, authenticator)
 			if err != nil {
 				return "", err
@@ -0,0 +1,183 @@
+package datasources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ryanolee/terraform-provider-talk/internal/hue"
+)
+
+type BehaviorScriptsDataSource struct {
+	client *hue.Client
+}
+
+type (
+	BehaviorScriptsDataSourceModel struct {
+		Scripts []behaviorScriptModel `tfsdk:"scripts"`
+	}
+
+	behaviorScriptModel struct {
+		Id                  types.String   `tfsdk:"id"`
+		Name                types.String   `tfsdk:"name"`
+		Category            types.String   `tfsdk:"category"`
+		Description         types.String   `tfsdk:"description"`
+		Version             types.String   `tfsdk:"version"`
+		SupportedFeatures   []types.String `tfsdk:"supported_features"`
+		MaxNumberInstances  types.Int64    `tfsdk:"max_number_instances"`
+		ConfigurationSchema types.String   `tfsdk:"configuration_schema"`
+		TriggerSchema       types.String   `tfsdk:"trigger_schema"`
+		StateSchema         types.String   `tfsdk:"state_schema"`
+	}
+)
+
+func NewBehaviorScriptsDataSource() datasource.DataSource {
+	return &BehaviorScriptsDataSource{}
+}
+
+func (d *BehaviorScriptsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_behavior_scripts", req.ProviderTypeName)
+}
+
+func (d *BehaviorScriptsDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"scripts": schema.ListNestedAttribute{
+				Description: "The behavior scripts of the bridge",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the script",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the script, for example `Wake up`",
+							Computed:    true,
+						},
+						"category": schema.StringAttribute{
+							Description: "The category of the script, for example `automation` or `entertainment`",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "A description of what the script does",
+							Computed:    true,
+						},
+						"version": schema.StringAttribute{
+							Description: "The version of the script",
+							Computed:    true,
+						},
+						"supported_features": schema.ListAttribute{
+							Description: "The features the script supports",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"max_number_instances": schema.Int64Attribute{
+							Description: "The most instances of the script the bridge allows, if limited",
+							Computed:    true,
+						},
+						"configuration_schema": schema.StringAttribute{
+							Description: "The JSON schema of the configuration of instances of the script, as a JSON string",
+							Computed:    true,
+						},
+						"trigger_schema": schema.StringAttribute{
+							Description: "The JSON schema of the triggers the script accepts, as a JSON string",
+							Computed:    true,
+						},
+						"state_schema": schema.StringAttribute{
+							Description: "The JSON schema of the state instances of the script report, as a JSON string",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Description: "The behavior scripts the bridge ships with, which automations are created from",
+	}
+}
+
+func (d *BehaviorScriptsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("failed to read behavior scripts", "client is nil")
+		return
+	}
+
+	var model BehaviorScriptsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scripts, err := d.client.GetBehaviorScripts(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get behavior scripts", fmt.Sprintf("failed to get behavior scripts: %s", err.Error()))
+		return
+	}
+
+	model.Scripts = []behaviorScriptModel{}
+	for _, script := range scripts {
+		model.Scripts = append(model.Scripts, mapBehaviorScriptToModel(&script))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (d *BehaviorScriptsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Configure can be called multiple times (sometimes without provider data)
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*hue.Client)
+	if !ok {
+		resp.Diagnostics.AddError("expected hue.Client", fmt.Sprintf("Expected *hue.Client, got %T", req.ProviderData))
+		return
+	}
+
+	d.client = client
+}
+
+func mapBehaviorScriptToModel(script *hue.BehaviorScript) behaviorScriptModel {
+	model := behaviorScriptModel{
+		Id:                  types.StringPointerValue(script.Id),
+		Name:                types.StringNull(),
+		Category:            types.StringNull(),
+		Description:         types.StringPointerValue(script.Description),
+		Version:             types.StringPointerValue(script.Version),
+		SupportedFeatures:   []types.String{},
+		MaxNumberInstances:  types.Int64Null(),
+		ConfigurationSchema: mapJsonToModel(script.ConfigurationSchema),
+		TriggerSchema:       mapJsonToModel(script.TriggerSchema),
+		StateSchema:         mapJsonToModel(script.StateSchema),
+	}
+
+	if script.Metadata != nil {
+		model.Name = types.StringPointerValue(script.Metadata.Name)
+		model.Category = types.StringPointerValue(script.Metadata.Category)
+	}
+
+	for _, feature := range script.SupportedFeatures {
+		model.SupportedFeatures = append(model.SupportedFeatures, types.StringValue(feature))
+	}
+
+	if script.MaxNumberInstances != nil {
+		model.MaxNumberInstances = types.Int64Value(int64(*script.MaxNumberInstances))
+	}
+
+	return model
+}
+
+// mapJsonToModel returns the raw JSON as a string, or null if the bridge did not send it
+func mapJsonToModel(raw json.RawMessage) types.String {
+	if len(raw) == 0 {
+		return types.StringNull()
+	}
+
+	return types.StringValue(string(raw))
+}
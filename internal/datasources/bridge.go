@@ -0,0 +1,175 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/ryanolee/terraform-provider-talk/internal/hue"
+)
+
+type BridgeDataSource struct {
+	client *hue.Client
+}
+
+type BridgeDataSourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	BridgeId        types.String `tfsdk:"bridge_id"`
+	IpAddress       types.String `tfsdk:"ip_address"`
+	IpSource        types.String `tfsdk:"ip_source"`
+	ModelId         types.String `tfsdk:"model_id"`
+	ProductName     types.String `tfsdk:"product_name"`
+	SoftwareVersion types.String `tfsdk:"software_version"`
+	TimeZone        types.String `tfsdk:"time_zone"`
+	BridgeHomeId    types.String `tfsdk:"bridge_home_id"`
+}
+
+func NewBridgeDataSource() datasource.DataSource {
+	return &BridgeDataSource{}
+}
+
+func (d *BridgeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_bridge", req.ProviderTypeName)
+}
+
+func (d *BridgeDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the bridge resource",
+				Computed:    true,
+			},
+			"bridge_id": schema.StringAttribute{
+				Description: "The unique hardware ID of the bridge",
+				Computed:    true,
+			},
+			"ip_address": schema.StringAttribute{
+				Description: "The IP address the provider used to connect to the bridge",
+				Computed:    true,
+			},
+			"ip_source": schema.StringAttribute{
+				Description: "Where the IP address was resolved from. One of `provider`, `environment`, `cache` or `discovery`",
+				Computed:    true,
+			},
+			"model_id": schema.StringAttribute{
+				Description: "The model ID of the bridge",
+				Computed:    true,
+			},
+			"product_name": schema.StringAttribute{
+				Description: "The product name of the bridge",
+				Computed:    true,
+			},
+			"software_version": schema.StringAttribute{
+				Description: "The software version the bridge is running",
+				Computed:    true,
+			},
+			"time_zone": schema.StringAttribute{
+				Description: "The time zone configured on the bridge",
+				Computed:    true,
+			},
+			"bridge_home_id": schema.StringAttribute{
+				Description: "The ID of the bridge home that all rooms and zones belong to",
+				Computed:    true,
+			},
+		},
+		Description: "The Hue bridge the provider is connected to",
+	}
+}
+
+func (d *BridgeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("failed to read bridge", "client is nil")
+		return
+	}
+
+	var model BridgeDataSourceModel
+
+	bridgesResp, err := d.client.GetBridgesWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get bridge", fmt.Sprintf("failed to get bridge: %s", err.Error()))
+		return
+	}
+
+	if bridgesResp.HTTPResponse.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("failed to get bridge", fmt.Sprintf("failed to get bridge: %s, %s", bridgesResp.HTTPResponse.Status, string(bridgesResp.Body)))
+		return
+	}
+
+	if bridgesResp.JSON200 == nil || bridgesResp.JSON200.Data == nil || len(*bridgesResp.JSON200.Data) == 0 {
+		resp.Diagnostics.AddError("failed to get bridge", "failed to get bridge: no data in response body")
+		return
+	}
+
+	bridge := (*bridgesResp.JSON200.Data)[0]
+
+	model.Id = types.StringPointerValue(bridge.Id)
+	model.BridgeId = types.StringPointerValue(bridge.BridgeId)
+	model.IpAddress = types.StringValue(d.client.AuthConfig.BridgeIp)
+	model.IpSource = types.StringValue(d.client.AuthConfig.BridgeIpSource)
+	model.TimeZone = types.StringNull()
+	if bridge.TimeZone != nil {
+		model.TimeZone = types.StringPointerValue(bridge.TimeZone.TimeZone)
+	}
+
+	// Model and software version live on the device that owns the bridge service
+	model.ModelId = types.StringNull()
+	model.ProductName = types.StringNull()
+	model.SoftwareVersion = types.StringNull()
+	if bridge.Owner != nil && bridge.Owner.Rid != nil {
+		deviceResp, err := d.client.GetDeviceWithResponse(ctx, *bridge.Owner.Rid)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to get bridge device", fmt.Sprintf("failed to get bridge device: %s", err.Error()))
+			return
+		}
+
+		if deviceResp.HTTPResponse.StatusCode != http.StatusOK {
+			resp.Diagnostics.AddError("failed to get bridge device", fmt.Sprintf("failed to get bridge device: %s, %s", deviceResp.HTTPResponse.Status, string(deviceResp.Body)))
+			return
+		}
+
+		if deviceResp.JSON200 != nil && deviceResp.JSON200.Data != nil && len(*deviceResp.JSON200.Data) > 0 {
+			productData := (*deviceResp.JSON200.Data)[0].ProductData
+			if productData != nil {
+				model.ModelId = types.StringPointerValue(productData.ModelId)
+				model.ProductName = types.StringPointerValue(productData.ProductName)
+				model.SoftwareVersion = types.StringPointerValue(productData.SoftwareVersion)
+			}
+		}
+	}
+
+	homesResp, err := d.client.GetBridgeHomesWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get bridge home", fmt.Sprintf("failed to get bridge home: %s", err.Error()))
+		return
+	}
+
+	if homesResp.HTTPResponse.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("failed to get bridge home", fmt.Sprintf("failed to get bridge home: %s, %s", homesResp.HTTPResponse.Status, string(homesResp.Body)))
+		return
+	}
+
+	model.BridgeHomeId = types.StringNull()
+	if homesResp.JSON200 != nil && homesResp.JSON200.Data != nil && len(*homesResp.JSON200.Data) > 0 {
+		model.BridgeHomeId = types.StringPointerValue((*homesResp.JSON200.Data)[0].Id)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (d *BridgeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Configure can be called multiple times (sometimes without provider data)
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*hue.Client)
+	if !ok {
+		resp.Diagnostics.AddError("expected hue.Client", fmt.Sprintf("Expected *hue.Client, got %T", req.ProviderData))
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,256 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/openhue/openhue-go"
+	"github.com/ryanolee/terraform-provider-talk/internal/hue"
+)
+
+type DeviceHealthDataSource struct {
+	client *hue.Client
+}
+
+type (
+	DeviceHealthDataSourceModel struct {
+		BatteryLevelBelow types.Int64         `tfsdk:"battery_level_below"`
+		UnreachableOnly   types.Bool          `tfsdk:"unreachable_only"`
+		ServiceType       types.String        `tfsdk:"service_type"`
+		Devices           []deviceHealthModel `tfsdk:"devices"`
+	}
+
+	deviceHealthModel struct {
+		Id                 types.String `tfsdk:"id"`
+		Name               types.String `tfsdk:"name"`
+		ProductName        types.String `tfsdk:"product_name"`
+		BatteryLevel       types.Int64  `tfsdk:"battery_level"`
+		BatteryState       types.String `tfsdk:"battery_state"`
+		ConnectivityStatus types.String `tfsdk:"connectivity_status"`
+		Reachable          types.Bool   `tfsdk:"reachable"`
+	}
+)
+
+func NewDeviceHealthDataSource() datasource.DataSource {
+	return &DeviceHealthDataSource{}
+}
+
+func (d *DeviceHealthDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_device_health", req.ProviderTypeName)
+}
+
+func (d *DeviceHealthDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"battery_level_below": schema.Int64Attribute{
+				Description: "Only return battery powered devices with a battery level (in percent) below this value",
+				Optional:    true,
+			},
+			"unreachable_only": schema.BoolAttribute{
+				Description: "Only return devices whose zigbee connectivity is not `connected`",
+				Optional:    true,
+			},
+			"service_type": schema.StringAttribute{
+				Description: "Only return devices exposing a service of this type, for example `light` or `motion`",
+				Optional:    true,
+			},
+			"devices": schema.ListNestedAttribute{
+				Description: "The devices matching all of the given filters",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the device",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the device",
+							Computed:    true,
+						},
+						"product_name": schema.StringAttribute{
+							Description: "The product name of the device",
+							Computed:    true,
+						},
+						"battery_level": schema.Int64Attribute{
+							Description: "The battery level in percent. Null for mains powered devices",
+							Computed:    true,
+						},
+						"battery_state": schema.StringAttribute{
+							Description: "The battery state, one of `normal`, `low` or `critical`. Null for mains powered devices",
+							Computed:    true,
+						},
+						"connectivity_status": schema.StringAttribute{
+							Description: "The zigbee connectivity status, one of `connected`, `disconnected`, `connectivity_issue` or `unidirectional_incoming`. Null for devices without zigbee connectivity",
+							Computed:    true,
+						},
+						"reachable": schema.BoolAttribute{
+							Description: "Whether the device is reachable by the bridge",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+		Description: "The battery and connectivity health of the devices in the Hue system",
+	}
+}
+
+func (d *DeviceHealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("failed to read device health", "client is nil")
+		return
+	}
+
+	var model DeviceHealthDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	devicesResp, err := d.client.GetDevicesWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get devices", fmt.Sprintf("failed to get devices: %s", err.Error()))
+		return
+	}
+
+	if devicesResp.HTTPResponse.StatusCode != http.StatusOK || devicesResp.JSON200 == nil || devicesResp.JSON200.Data == nil {
+		resp.Diagnostics.AddError("failed to get devices", fmt.Sprintf("failed to get devices: %s, %s", devicesResp.HTTPResponse.Status, string(devicesResp.Body)))
+		return
+	}
+
+	powersResp, err := d.client.GetDevicePowersWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get device power", fmt.Sprintf("failed to get device power: %s", err.Error()))
+		return
+	}
+
+	if powersResp.HTTPResponse.StatusCode != http.StatusOK || powersResp.JSON200 == nil || powersResp.JSON200.Data == nil {
+		resp.Diagnostics.AddError("failed to get device power", fmt.Sprintf("failed to get device power: %s, %s", powersResp.HTTPResponse.Status, string(powersResp.Body)))
+		return
+	}
+
+	connectivities, err := d.client.GetZigbeeConnectivities(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get zigbee connectivity", fmt.Sprintf("failed to get zigbee connectivity: %s", err.Error()))
+		return
+	}
+
+	// Index battery and connectivity state by the device that owns them
+	batteryLevels := map[string]types.Int64{}
+	batteryStates := map[string]types.String{}
+	for _, power := range *powersResp.JSON200.Data {
+		if power.Owner == nil || power.Owner.Rid == nil || power.PowerState == nil {
+			continue
+		}
+
+		if power.PowerState.BatteryLevel != nil {
+			batteryLevels[*power.Owner.Rid] = types.Int64Value(int64(*power.PowerState.BatteryLevel))
+		}
+
+		if power.PowerState.BatteryState != nil {
+			batteryStates[*power.Owner.Rid] = types.StringValue(string(*power.PowerState.BatteryState))
+		}
+	}
+
+	connectivityStatuses := map[string]string{}
+	for _, connectivity := range connectivities {
+		if connectivity.Owner == nil || connectivity.Owner.Rid == nil || connectivity.Status == nil {
+			continue
+		}
+
+		connectivityStatuses[*connectivity.Owner.Rid] = *connectivity.Status
+	}
+
+	model.Devices = []deviceHealthModel{}
+	for _, device := range *devicesResp.JSON200.Data {
+		if device.Id == nil {
+			continue
+		}
+
+		deviceModel := deviceHealthModel{
+			Id:                 types.StringPointerValue(device.Id),
+			Name:               types.StringNull(),
+			ProductName:        types.StringNull(),
+			BatteryLevel:       types.Int64Null(),
+			BatteryState:       types.StringNull(),
+			ConnectivityStatus: types.StringNull(),
+			Reachable:          types.BoolValue(true),
+		}
+
+		if device.Metadata != nil {
+			deviceModel.Name = types.StringPointerValue(device.Metadata.Name)
+		}
+
+		if device.ProductData != nil {
+			deviceModel.ProductName = types.StringPointerValue(device.ProductData.ProductName)
+		}
+
+		if batteryLevel, ok := batteryLevels[*device.Id]; ok {
+			deviceModel.BatteryLevel = batteryLevel
+		}
+
+		if batteryState, ok := batteryStates[*device.Id]; ok {
+			deviceModel.BatteryState = batteryState
+		}
+
+		if status, ok := connectivityStatuses[*device.Id]; ok {
+			deviceModel.ConnectivityStatus = types.StringValue(status)
+			deviceModel.Reachable = types.BoolValue(status == hue.ZigbeeStatusConnected)
+		}
+
+		// Apply filters
+		if !model.BatteryLevelBelow.IsNull() {
+			if deviceModel.BatteryLevel.IsNull() || deviceModel.BatteryLevel.ValueInt64() >= model.BatteryLevelBelow.ValueInt64() {
+				continue
+			}
+		}
+
+		if model.UnreachableOnly.ValueBool() && deviceModel.Reachable.ValueBool() {
+			continue
+		}
+
+		if !model.ServiceType.IsNull() && !deviceHasServiceType(device.Services, model.ServiceType.ValueString()) {
+			continue
+		}
+
+		model.Devices = append(model.Devices, deviceModel)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (d *DeviceHealthDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Configure can be called multiple times (sometimes without provider data)
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*hue.Client)
+	if !ok {
+		resp.Diagnostics.AddError("expected hue.Client", fmt.Sprintf("Expected *hue.Client, got %T", req.ProviderData))
+		return
+	}
+
+	d.client = client
+}
+
+func deviceHasServiceType(services *[]openhue.ResourceIdentifier, serviceType string) bool {
+	if services == nil {
+		return false
+	}
+
+	for _, service := range *services {
+		if service.Rtype != nil && string(*service.Rtype) == serviceType {
+			return true
+		}
+	}
+
+	return false
+}
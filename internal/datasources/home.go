@@ -0,0 +1,389 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/openhue/openhue-go"
+	"github.com/ryanolee/terraform-provider-talk/internal/hue"
+)
+
+type HomeDataSource struct {
+	client *hue.Client
+}
+
+type (
+	HomeDataSourceModel struct {
+		Id             types.String      `tfsdk:"id"`
+		GroupedLightId types.String      `tfsdk:"grouped_light_id"`
+		Rooms          []homeGroupModel  `tfsdk:"rooms"`
+		Zones          []homeGroupModel  `tfsdk:"zones"`
+		Devices        []homeDeviceModel `tfsdk:"devices"`
+	}
+
+	homeGroupModel struct {
+		Id             types.String      `tfsdk:"id"`
+		Name           types.String      `tfsdk:"name"`
+		Archetype      types.String      `tfsdk:"archetype"`
+		GroupedLightId types.String      `tfsdk:"grouped_light_id"`
+		LightIds       []types.String    `tfsdk:"light_ids"`
+		Devices        []homeDeviceModel `tfsdk:"devices"`
+		Scenes         []homeSceneModel  `tfsdk:"scenes"`
+	}
+
+	homeDeviceModel struct {
+		Id          types.String       `tfsdk:"id"`
+		Name        types.String       `tfsdk:"name"`
+		Archetype   types.String       `tfsdk:"archetype"`
+		ProductName types.String       `tfsdk:"product_name"`
+		ModelId     types.String       `tfsdk:"model_id"`
+		Services    []homeServiceModel `tfsdk:"services"`
+	}
+
+	homeServiceModel struct {
+		Id   types.String `tfsdk:"id"`
+		Type types.String `tfsdk:"type"`
+	}
+
+	homeSceneModel struct {
+		Id   types.String `tfsdk:"id"`
+		Name types.String `tfsdk:"name"`
+	}
+)
+
+func NewHomeDataSource() datasource.DataSource {
+	return &HomeDataSource{}
+}
+
+func (d *HomeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_home", req.ProviderTypeName)
+}
+
+func (d *HomeDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the bridge_home, the root of the topology",
+				Computed:    true,
+			},
+			"grouped_light_id": schema.StringAttribute{
+				Description: "The ID of the grouped light controlling every light in the home",
+				Computed:    true,
+			},
+			"rooms": schema.ListNestedAttribute{
+				Description: "The rooms of the home",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: homeGroupAttributes("room"),
+				},
+			},
+			"zones": schema.ListNestedAttribute{
+				Description: "The zones of the home",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: homeGroupAttributes("zone"),
+				},
+			},
+			"devices": schema.ListNestedAttribute{
+				Description: "The devices that are not in a room, such as the bridge itself",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: homeDeviceAttributes(),
+				},
+			},
+		},
+		Description: "The whole topology of the home: rooms and zones with their devices, services, grouped lights and scenes. It is read in a single request, which makes it suited to templating dashboards or generating documentation.",
+	}
+}
+
+func homeGroupAttributes(groupType string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: fmt.Sprintf("The ID of the %s", groupType),
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: fmt.Sprintf("The name of the %s", groupType),
+			Computed:    true,
+		},
+		"archetype": schema.StringAttribute{
+			Description: fmt.Sprintf("The archetype of the %s, for example `living_room`", groupType),
+			Computed:    true,
+		},
+		"grouped_light_id": schema.StringAttribute{
+			Description: fmt.Sprintf("The ID of the grouped light controlling the lights in the %s", groupType),
+			Computed:    true,
+		},
+		"light_ids": schema.ListAttribute{
+			Description: fmt.Sprintf("The IDs of the lights in the %s", groupType),
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"devices": schema.ListNestedAttribute{
+			Description: fmt.Sprintf("The devices in the %s", groupType),
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: homeDeviceAttributes(),
+			},
+		},
+		"scenes": schema.ListNestedAttribute{
+			Description: fmt.Sprintf("The scenes of the %s", groupType),
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description: "The ID of the scene",
+						Computed:    true,
+					},
+					"name": schema.StringAttribute{
+						Description: "The name of the scene",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func homeDeviceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the device",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of the device",
+			Computed:    true,
+		},
+		"archetype": schema.StringAttribute{
+			Description: "The archetype of the device, for example `sultan_bulb`",
+			Computed:    true,
+		},
+		"product_name": schema.StringAttribute{
+			Description: "The product name of the device, for example `Hue color lamp`",
+			Computed:    true,
+		},
+		"model_id": schema.StringAttribute{
+			Description: "The model ID of the device",
+			Computed:    true,
+		},
+		"services": schema.ListNestedAttribute{
+			Description: "The services the device exposes, such as its light, motion or zigbee_connectivity",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description: "The ID of the service",
+						Computed:    true,
+					},
+					"type": schema.StringAttribute{
+						Description: "The type of the service, for example `light`",
+						Computed:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *HomeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("failed to read home", "client is nil")
+		return
+	}
+
+	var model HomeDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	home, err := d.client.GetHome(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get home", fmt.Sprintf("failed to get home: %s", err.Error()))
+		return
+	}
+
+	model = mapHomeToModel(home)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (d *HomeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Configure can be called multiple times (sometimes without provider data)
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*hue.Client)
+	if !ok {
+		resp.Diagnostics.AddError("expected hue.Client", fmt.Sprintf("Expected *hue.Client, got %T", req.ProviderData))
+		return
+	}
+
+	d.client = client
+}
+
+func mapHomeToModel(home *hue.Home) HomeDataSourceModel {
+	model := HomeDataSourceModel{
+		Id:             types.StringNull(),
+		GroupedLightId: types.StringNull(),
+		Rooms:          []homeGroupModel{},
+		Zones:          []homeGroupModel{},
+		Devices:        []homeDeviceModel{},
+	}
+
+	// Scenes point at their group rather than the other way around
+	scenesByGroup := map[string][]homeSceneModel{}
+	for _, scene := range home.ByType["scene"] {
+		if scene.Group == nil || scene.Group.Rid == nil {
+			continue
+		}
+
+		scenesByGroup[*scene.Group.Rid] = append(scenesByGroup[*scene.Group.Rid], homeSceneModel{
+			Id:   types.StringPointerValue(scene.Id),
+			Name: homeResourceName(scene),
+		})
+	}
+
+	if bridgeHomes := home.ByType["bridge_home"]; len(bridgeHomes) > 0 {
+		bridgeHome := bridgeHomes[0]
+		model.Id = types.StringPointerValue(bridgeHome.Id)
+		model.GroupedLightId = homeGroupedLightId(bridgeHome)
+
+		for _, child := range bridgeHome.Children {
+			if device, ok := home.Resource(child); ok && *device.Type == "device" {
+				model.Devices = append(model.Devices, mapHomeDeviceToModel(home, device))
+			}
+		}
+	}
+
+	for _, room := range home.ByType["room"] {
+		model.Rooms = append(model.Rooms, mapHomeGroupToModel(home, room, scenesByGroup))
+	}
+
+	for _, zone := range home.ByType["zone"] {
+		model.Zones = append(model.Zones, mapHomeGroupToModel(home, zone, scenesByGroup))
+	}
+
+	return model
+}
+
+// mapHomeGroupToModel maps a room, whose children are devices, or a zone, whose children are the
+// services of devices
+func mapHomeGroupToModel(home *hue.Home, group hue.HomeResource, scenesByGroup map[string][]homeSceneModel) homeGroupModel {
+	model := homeGroupModel{
+		Id:             types.StringPointerValue(group.Id),
+		Name:           homeResourceName(group),
+		Archetype:      homeResourceArchetype(group),
+		GroupedLightId: homeGroupedLightId(group),
+		LightIds:       []types.String{},
+		Devices:        []homeDeviceModel{},
+		Scenes:         scenesByGroup[*group.Id],
+	}
+
+	if model.Scenes == nil {
+		model.Scenes = []homeSceneModel{}
+	}
+
+	seenDevices := map[string]bool{}
+	addDevice := func(device hue.HomeResource) {
+		if seenDevices[*device.Id] {
+			return
+		}
+
+		seenDevices[*device.Id] = true
+		model.Devices = append(model.Devices, mapHomeDeviceToModel(home, device))
+	}
+
+	for _, child := range group.Children {
+		resource, ok := home.Resource(child)
+		if !ok {
+			continue
+		}
+
+		if *resource.Type == "device" {
+			addDevice(resource)
+
+			for _, service := range resource.Services {
+				if service.Rtype != nil && *service.Rtype == openhue.ResourceIdentifierRtypeLight && service.Rid != nil {
+					model.LightIds = append(model.LightIds, types.StringValue(*service.Rid))
+				}
+			}
+
+			continue
+		}
+
+		if *resource.Type == "light" {
+			model.LightIds = append(model.LightIds, types.StringPointerValue(resource.Id))
+		}
+
+		if resource.Owner == nil {
+			continue
+		}
+
+		if device, ok := home.Resource(*resource.Owner); ok && *device.Type == "device" {
+			addDevice(device)
+		}
+	}
+
+	return model
+}
+
+func mapHomeDeviceToModel(home *hue.Home, device hue.HomeResource) homeDeviceModel {
+	model := homeDeviceModel{
+		Id:          types.StringPointerValue(device.Id),
+		Name:        homeResourceName(device),
+		Archetype:   homeResourceArchetype(device),
+		ProductName: types.StringNull(),
+		ModelId:     types.StringNull(),
+		Services:    []homeServiceModel{},
+	}
+
+	if device.ProductData != nil {
+		model.ProductName = types.StringPointerValue(device.ProductData.ProductName)
+		model.ModelId = types.StringPointerValue(device.ProductData.ModelId)
+	}
+
+	for _, service := range device.Services {
+		model.Services = append(model.Services, homeServiceModel{
+			Id:   types.StringPointerValue(service.Rid),
+			Type: types.StringPointerValue((*string)(service.Rtype)),
+		})
+	}
+
+	return model
+}
+
+// homeGroupedLightId returns the ID of the grouped_light service of a room, zone or bridge_home
+func homeGroupedLightId(group hue.HomeResource) types.String {
+	for _, service := range group.Services {
+		if service.Rtype != nil && *service.Rtype == openhue.ResourceIdentifierRtypeGroupedLight {
+			return types.StringPointerValue(service.Rid)
+		}
+	}
+
+	return types.StringNull()
+}
+
+func homeResourceName(resource hue.HomeResource) types.String {
+	if resource.Metadata == nil {
+		return types.StringNull()
+	}
+
+	return types.StringPointerValue(resource.Metadata.Name)
+}
+
+func homeResourceArchetype(resource hue.HomeResource) types.String {
+	if resource.Metadata == nil {
+		return types.StringNull()
+	}
+
+	return types.StringPointerValue(resource.Metadata.Archetype)
+}
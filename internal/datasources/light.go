@@ -3,23 +3,25 @@ package datasources
 import (
 	"context"
 	"fmt"
-	"net/http"
-	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/openhue/openhue-go"
+	"github.com/ryanolee/terraform-provider-talk/internal/hue"
 )
 
 type LightDataSource struct {
-	client *openhue.ClientWithResponses
+	client *hue.Client
 }
 
 type LightDataSourceModel struct {
-	Id   types.String `tfsdk:"id"`
-	Name types.String `tfsdk:"name"`
-	On   types.Bool   `tfsdk:"on"`
+	Id    types.String `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Room  types.String `tfsdk:"room"`
+	Match types.String `tfsdk:"match"`
+	On    types.Bool   `tfsdk:"on"`
 }
 
 func NewLightDataSource() datasource.DataSource {
@@ -34,13 +36,30 @@ func (d *LightDataSource) Schema(_ context.Context, req datasource.SchemaRequest
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
-				Required: true,
+				Description: "The name of the light, compared according to `match`. At least one of `name` or `id` must be set",
+				Optional:    true,
+				Computed:    true,
 			},
 			"id": schema.StringAttribute{
-				Computed: true,
+				Description: "The ID of the light",
+				Optional:    true,
+				Computed:    true,
+			},
+			"room": schema.StringAttribute{
+				Description: "The name of the room the light is in, compared according to `match`. Use this to pick between lights with the same name",
+				Optional:    true,
+				Computed:    true,
+			},
+			"match": schema.StringAttribute{
+				Description: "How `name` and `room` are compared, one of `exact`, `case_insensitive` or `regex`. Defaults to `exact`",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(hue.LightMatchExact, hue.LightMatchCaseInsensitive, hue.LightMatchRegex),
+				},
 			},
 			"on": schema.BoolAttribute{
-				Computed: true,
+				Description: "Whether the light is on",
+				Computed:    true,
 			},
 		},
 	}
@@ -56,43 +75,37 @@ func (d *LightDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	parsedApiResp, err := d.client.GetLightsWithResponse(ctx)
+	selector := hue.LightSelector{
+		Id:    model.Id.ValueString(),
+		Name:  model.Name.ValueString(),
+		Room:  model.Room.ValueString(),
+		Match: model.Match.ValueString(),
+	}
 
+	targetLight, err := d.client.FindLight(ctx, selector)
 	if err != nil {
-		resp.Diagnostics.AddError("failed to find light", fmt.Sprintf("failed to create light: %s", err.Error()))
+		resp.Diagnostics.AddError("failed to find light", fmt.Sprintf("failed to find light: %s", err.Error()))
 		return
 	}
 
-	if parsedApiResp.HTTPResponse.StatusCode != http.StatusOK {
-		resp.Diagnostics.AddError("failed to find light", fmt.Sprintf("failed to create light: %s, %s", parsedApiResp.HTTPResponse.Status, string(parsedApiResp.Body)))
+	roomNames, err := d.client.GetDeviceRoomNames(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get rooms", fmt.Sprintf("failed to get rooms: %s", err.Error()))
 		return
 	}
 
-	getLightResponse := *parsedApiResp.JSON200.Data
+	// Set the ID of the light. Configured selectors are kept as they are, since they may be patterns
+	model.Id = types.StringPointerValue(targetLight.Id)
+	model.On = types.BoolPointerValue(targetLight.On.On)
 
-	// Search for the light in the response
-	var targetLight *openhue.LightGet
-	for _, light := range getLightResponse {
-		if *light.Metadata.Name == model.Name.ValueString() {
-			targetLight = &light
-			break
-		}
+	if model.Name.IsNull() {
+		model.Name = types.StringPointerValue(targetLight.Metadata.Name)
 	}
 
-	if targetLight == nil {
-		lampNames := make([]string, len(getLightResponse))
-		for i, light := range getLightResponse {
-			lampNames[i] = *light.Metadata.Name
-		}
-
-		resp.Diagnostics.AddError("failed to find light", fmt.Sprintf("failed to create light: light %s not found. Available lamps %s", model.Name.String(), strings.Join(lampNames, ", ")))
-		return
+	if room := hue.LightRoomName(roomNames, targetLight); model.Room.IsNull() && room != "" {
+		model.Room = types.StringValue(room)
 	}
 
-	// Set the ID of the light
-	model.Id = types.StringPointerValue(targetLight.Id)
-	model.On = types.BoolPointerValue(targetLight.On.On)
-
 	// Set the model in the response
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 
@@ -104,9 +117,9 @@ func (d *LightDataSource) Configure(ctx context.Context, req datasource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*openhue.ClientWithResponses)
+	client, ok := req.ProviderData.(*hue.Client)
 	if !ok {
-		resp.Diagnostics.AddError("expected openhue.ClientWithResponses", fmt.Sprintf("Expected *openhue.Client, got %T", req.ProviderData))
+		resp.Diagnostics.AddError("expected hue.Client", fmt.Sprintf("Expected *hue.Client, got %T", req.ProviderData))
 		return
 	}
 
@@ -0,0 +1,124 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/ryanolee/terraform-provider-talk/internal/hue"
+)
+
+type SceneDataSource struct {
+	client *hue.Client
+}
+
+func NewSceneDataSource() datasource.DataSource {
+	return &SceneDataSource{}
+}
+
+func (d *SceneDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_scene", req.ProviderTypeName)
+}
+
+func (d *SceneDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: sceneAttributes(map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the scene",
+				Optional:    true,
+				Computed:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the scene. At least one of `name` or `id` must be set",
+				Optional:    true,
+				Computed:    true,
+			},
+			"group_id": schema.StringAttribute{
+				Description: "The ID of the room or zone the scene belongs to. Use this to pick between scenes with the same name in different rooms",
+				Optional:    true,
+				Computed:    true,
+			},
+		}),
+		Description: "A single scene in the Hue system, for example one created in the Hue app",
+	}
+}
+
+func (d *SceneDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("failed to read scene", "client is nil")
+		return
+	}
+
+	var model sceneModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if model.Id.IsNull() && model.Name.IsNull() {
+		resp.Diagnostics.AddError("failed to find scene", "at least one of name or id must be set")
+		return
+	}
+
+	scenes, err := getScenes(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get scenes", fmt.Sprintf("failed to get scenes: %s", err.Error()))
+		return
+	}
+
+	candidates := []sceneModel{}
+	for _, scene := range scenes {
+		sceneModel := mapSceneToModel(&scene)
+
+		if !model.Id.IsNull() && sceneModel.Id.ValueString() != model.Id.ValueString() {
+			continue
+		}
+
+		if !model.Name.IsNull() && sceneModel.Name.ValueString() != model.Name.ValueString() {
+			continue
+		}
+
+		if !model.GroupId.IsNull() && sceneModel.GroupId.ValueString() != model.GroupId.ValueString() {
+			continue
+		}
+
+		candidates = append(candidates, sceneModel)
+	}
+
+	switch len(candidates) {
+	case 0:
+		resp.Diagnostics.AddError("failed to find scene", "failed to find scene: no scene matches the given id, name and group_id")
+		return
+	case 1:
+	default:
+		candidateIds := make([]string, len(candidates))
+		for i, candidate := range candidates {
+			candidateIds[i] = fmt.Sprintf("%s (group %s)", candidate.Id.ValueString(), candidate.GroupId.ValueString())
+		}
+
+		resp.Diagnostics.AddError("failed to find scene", fmt.Sprintf("failed to find scene: %d scenes match, set id or group_id to pick one: %s", len(candidates), strings.Join(candidateIds, ", ")))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &candidates[0])...)
+}
+
+func (d *SceneDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Configure can be called multiple times (sometimes without provider data)
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*hue.Client)
+	if !ok {
+		resp.Diagnostics.AddError("expected hue.Client", fmt.Sprintf("Expected *hue.Client, got %T", req.ProviderData))
+		return
+	}
+
+	d.client = client
+}
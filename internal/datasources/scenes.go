@@ -0,0 +1,455 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/openhue/openhue-go"
+	"github.com/ryanolee/terraform-provider-talk/internal/hue"
+)
+
+type ScenesDataSource struct {
+	client *hue.Client
+}
+
+type (
+	ScenesDataSourceModel struct {
+		GroupId types.String `tfsdk:"group_id"`
+		Name    types.String `tfsdk:"name"`
+		Scenes  []sceneModel `tfsdk:"scenes"`
+	}
+
+	sceneModel struct {
+		Id          types.String       `tfsdk:"id"`
+		Name        types.String       `tfsdk:"name"`
+		GroupId     types.String       `tfsdk:"group_id"`
+		GroupType   types.String       `tfsdk:"group_type"`
+		Actions     []sceneActionModel `tfsdk:"actions"`
+		Palette     *scenePaletteModel `tfsdk:"palette"`
+		Speed       types.Float32      `tfsdk:"speed"`
+		AutoDynamic types.Bool         `tfsdk:"auto_dynamic"`
+		Active      types.Bool         `tfsdk:"active"`
+		Status      types.String       `tfsdk:"status"`
+	}
+
+	sceneActionModel struct {
+		TargetId         types.String     `tfsdk:"target_id"`
+		TargetType       types.String     `tfsdk:"target_type"`
+		On               types.Bool       `tfsdk:"on"`
+		Brightness       types.Float32    `tfsdk:"brightness"`
+		Color            *sceneColorModel `tfsdk:"color"`
+		ColorTemperature types.Int64      `tfsdk:"color_temperature"`
+		Effect           types.String     `tfsdk:"effect"`
+	}
+
+	sceneColorModel struct {
+		X types.Float32 `tfsdk:"x"`
+		Y types.Float32 `tfsdk:"y"`
+	}
+
+	scenePaletteModel struct {
+		Colors            []scenePaletteColorModel            `tfsdk:"colors"`
+		ColorTemperatures []scenePaletteColorTemperatureModel `tfsdk:"color_temperatures"`
+		Brightness        []types.Float32                     `tfsdk:"brightness"`
+		Effects           []types.String                      `tfsdk:"effects"`
+	}
+
+	scenePaletteColorModel struct {
+		X          types.Float32 `tfsdk:"x"`
+		Y          types.Float32 `tfsdk:"y"`
+		Brightness types.Float32 `tfsdk:"brightness"`
+	}
+
+	scenePaletteColorTemperatureModel struct {
+		Mirek      types.Int64   `tfsdk:"mirek"`
+		Brightness types.Float32 `tfsdk:"brightness"`
+	}
+)
+
+func NewScenesDataSource() datasource.DataSource {
+	return &ScenesDataSource{}
+}
+
+func (d *ScenesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_scenes", req.ProviderTypeName)
+}
+
+func (d *ScenesDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"group_id": schema.StringAttribute{
+				Description: "Only return scenes of the room or zone with this ID",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Only return scenes with this name",
+				Optional:    true,
+			},
+			"scenes": schema.ListNestedAttribute{
+				Description: "The scenes matching all of the given filters",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: sceneAttributes(map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the scene",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the scene",
+							Computed:    true,
+						},
+						"group_id": schema.StringAttribute{
+							Description: "The ID of the room or zone the scene belongs to",
+							Computed:    true,
+						},
+					}),
+				},
+			},
+		},
+		Description: "The scenes in the Hue system, including the ones created in the Hue app",
+	}
+}
+
+func (d *ScenesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("failed to read scenes", "client is nil")
+		return
+	}
+
+	var model ScenesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scenes, err := getScenes(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get scenes", fmt.Sprintf("failed to get scenes: %s", err.Error()))
+		return
+	}
+
+	model.Scenes = []sceneModel{}
+	for _, scene := range scenes {
+		sceneModel := mapSceneToModel(&scene)
+
+		if !model.GroupId.IsNull() && sceneModel.GroupId.ValueString() != model.GroupId.ValueString() {
+			continue
+		}
+
+		if !model.Name.IsNull() && sceneModel.Name.ValueString() != model.Name.ValueString() {
+			continue
+		}
+
+		model.Scenes = append(model.Scenes, sceneModel)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (d *ScenesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Configure can be called multiple times (sometimes without provider data)
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*hue.Client)
+	if !ok {
+		resp.Diagnostics.AddError("expected hue.Client", fmt.Sprintf("Expected *hue.Client, got %T", req.ProviderData))
+		return
+	}
+
+	d.client = client
+}
+
+// sceneAttributes returns the attributes describing a scene, merged with the given identifying attributes
+func sceneAttributes(identifiers map[string]schema.Attribute) map[string]schema.Attribute {
+	attributes := map[string]schema.Attribute{
+		"group_type": schema.StringAttribute{
+			Description: "The type of group the scene belongs to, either `room` or `zone`",
+			Computed:    true,
+		},
+		"actions": schema.ListNestedAttribute{
+			Description: "The state each light is put in when the scene is recalled",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"target_id": schema.StringAttribute{
+						Description: "The ID of the light the action applies to",
+						Computed:    true,
+					},
+					"target_type": schema.StringAttribute{
+						Description: "The type of resource the action applies to, usually `light`",
+						Computed:    true,
+					},
+					"on": schema.BoolAttribute{
+						Description: "Whether the light is turned on",
+						Computed:    true,
+					},
+					"brightness": schema.Float32Attribute{
+						Description: "The brightness of the light in percent",
+						Computed:    true,
+					},
+					"color": schema.SingleNestedAttribute{
+						Description: "The color of the light in CIE xy coordinates",
+						Computed:    true,
+						Attributes: map[string]schema.Attribute{
+							"x": schema.Float32Attribute{
+								Computed: true,
+							},
+							"y": schema.Float32Attribute{
+								Computed: true,
+							},
+						},
+					},
+					"color_temperature": schema.Int64Attribute{
+						Description: "The color temperature of the light in mirek",
+						Computed:    true,
+					},
+					"effect": schema.StringAttribute{
+						Description: "The effect played on the light",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"palette": schema.SingleNestedAttribute{
+			Description: "The colors the scene cycles through when played dynamically",
+			Computed:    true,
+			Attributes: map[string]schema.Attribute{
+				"colors": schema.ListNestedAttribute{
+					Description: "Colors in CIE xy coordinates, with their brightness",
+					Computed:    true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"x": schema.Float32Attribute{
+								Computed: true,
+							},
+							"y": schema.Float32Attribute{
+								Computed: true,
+							},
+							"brightness": schema.Float32Attribute{
+								Computed: true,
+							},
+						},
+					},
+				},
+				"color_temperatures": schema.ListNestedAttribute{
+					Description: "Color temperatures in mirek, with their brightness",
+					Computed:    true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"mirek": schema.Int64Attribute{
+								Computed: true,
+							},
+							"brightness": schema.Float32Attribute{
+								Computed: true,
+							},
+						},
+					},
+				},
+				"brightness": schema.ListAttribute{
+					Description: "Brightness levels in percent",
+					ElementType: types.Float32Type,
+					Computed:    true,
+				},
+				"effects": schema.ListAttribute{
+					Description: "Effects",
+					ElementType: types.StringType,
+					Computed:    true,
+				},
+			},
+		},
+		"speed": schema.Float32Attribute{
+			Description: "The speed at which the palette is played, between 0 and 1",
+			Computed:    true,
+		},
+		"auto_dynamic": schema.BoolAttribute{
+			Description: "Whether the scene starts playing dynamically when it is recalled",
+			Computed:    true,
+		},
+		"active": schema.BoolAttribute{
+			Description: "Whether the scene is currently active",
+			Computed:    true,
+		},
+		"status": schema.StringAttribute{
+			Description: "The activation status of the scene, one of `inactive`, `static` or `dynamic_palette`",
+			Computed:    true,
+		},
+	}
+
+	for name, attribute := range identifiers {
+		attributes[name] = attribute
+	}
+
+	return attributes
+}
+
+func getScenes(ctx context.Context, client *hue.Client) ([]openhue.SceneGet, error) {
+	apiResp, err := client.GetScenesWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if apiResp.HTTPResponse.StatusCode != http.StatusOK || apiResp.JSON200 == nil || apiResp.JSON200.Data == nil {
+		return nil, fmt.Errorf("%s, %s", apiResp.HTTPResponse.Status, string(apiResp.Body))
+	}
+
+	return *apiResp.JSON200.Data, nil
+}
+
+func mapSceneToModel(scene *openhue.SceneGet) sceneModel {
+	model := sceneModel{
+		Id:          types.StringPointerValue(scene.Id),
+		Name:        types.StringNull(),
+		GroupId:     types.StringNull(),
+		GroupType:   types.StringNull(),
+		Actions:     []sceneActionModel{},
+		Speed:       types.Float32PointerValue(scene.Speed),
+		AutoDynamic: types.BoolPointerValue(scene.AutoDynamic),
+		Active:      types.BoolValue(false),
+		Status:      types.StringValue(string(openhue.SceneGetStatusActiveInactive)),
+	}
+
+	if scene.Metadata != nil {
+		model.Name = types.StringPointerValue(scene.Metadata.Name)
+	}
+
+	if scene.Group != nil {
+		model.GroupId = types.StringPointerValue(scene.Group.Rid)
+		model.GroupType = types.StringPointerValue((*string)(scene.Group.Rtype))
+	}
+
+	if scene.Status != nil && scene.Status.Active != nil {
+		model.Status = types.StringValue(string(*scene.Status.Active))
+		model.Active = types.BoolValue(*scene.Status.Active != openhue.SceneGetStatusActiveInactive)
+	}
+
+	if scene.Actions != nil {
+		for _, action := range *scene.Actions {
+			model.Actions = append(model.Actions, mapSceneActionToModel(&action))
+		}
+	}
+
+	if scene.Palette != nil {
+		model.Palette = mapScenePaletteToModel(scene.Palette)
+	}
+
+	return model
+}
+
+func mapSceneActionToModel(action *openhue.ActionGet) sceneActionModel {
+	model := sceneActionModel{
+		TargetId:         types.StringNull(),
+		TargetType:       types.StringNull(),
+		On:               types.BoolNull(),
+		Brightness:       types.Float32Null(),
+		ColorTemperature: types.Int64Null(),
+		Effect:           types.StringNull(),
+	}
+
+	if action.Target != nil {
+		model.TargetId = types.StringPointerValue(action.Target.Rid)
+		model.TargetType = types.StringPointerValue((*string)(action.Target.Rtype))
+	}
+
+	if action.Action == nil {
+		return model
+	}
+
+	if action.Action.On != nil {
+		model.On = types.BoolPointerValue(action.Action.On.On)
+	}
+
+	if action.Action.Dimming != nil {
+		model.Brightness = types.Float32PointerValue(action.Action.Dimming.Brightness)
+	}
+
+	if action.Action.Color != nil && action.Action.Color.Xy != nil {
+		model.Color = &sceneColorModel{
+			X: types.Float32PointerValue(action.Action.Color.Xy.X),
+			Y: types.Float32PointerValue(action.Action.Color.Xy.Y),
+		}
+	}
+
+	if action.Action.ColorTemperature != nil && action.Action.ColorTemperature.Mirek != nil {
+		model.ColorTemperature = types.Int64Value(int64(*action.Action.ColorTemperature.Mirek))
+	}
+
+	if action.Action.Effects != nil && action.Action.Effects.Effect != nil {
+		model.Effect = types.StringValue(string(*action.Action.Effects.Effect))
+	}
+
+	return model
+}
+
+func mapScenePaletteToModel(palette *openhue.ScenePalette) *scenePaletteModel {
+	model := &scenePaletteModel{
+		Colors:            []scenePaletteColorModel{},
+		ColorTemperatures: []scenePaletteColorTemperatureModel{},
+		Brightness:        []types.Float32{},
+		Effects:           []types.String{},
+	}
+
+	if palette.Color != nil {
+		for _, color := range *palette.Color {
+			colorModel := scenePaletteColorModel{
+				X:          types.Float32Null(),
+				Y:          types.Float32Null(),
+				Brightness: types.Float32Null(),
+			}
+
+			if color.Color != nil && color.Color.Xy != nil {
+				colorModel.X = types.Float32PointerValue(color.Color.Xy.X)
+				colorModel.Y = types.Float32PointerValue(color.Color.Xy.Y)
+			}
+
+			if color.Dimming != nil {
+				colorModel.Brightness = types.Float32PointerValue(color.Dimming.Brightness)
+			}
+
+			model.Colors = append(model.Colors, colorModel)
+		}
+	}
+
+	if palette.ColorTemperature != nil {
+		for _, colorTemperature := range *palette.ColorTemperature {
+			colorTemperatureModel := scenePaletteColorTemperatureModel{
+				Mirek:      types.Int64Null(),
+				Brightness: types.Float32Null(),
+			}
+
+			if colorTemperature.ColorTemperature != nil && colorTemperature.ColorTemperature.Mirek != nil {
+				colorTemperatureModel.Mirek = types.Int64Value(int64(*colorTemperature.ColorTemperature.Mirek))
+			}
+
+			if colorTemperature.Dimming != nil {
+				colorTemperatureModel.Brightness = types.Float32PointerValue(colorTemperature.Dimming.Brightness)
+			}
+
+			model.ColorTemperatures = append(model.ColorTemperatures, colorTemperatureModel)
+		}
+	}
+
+	if palette.Dimming != nil {
+		for _, dimming := range *palette.Dimming {
+			model.Brightness = append(model.Brightness, types.Float32PointerValue(dimming.Brightness))
+		}
+	}
+
+	if palette.Effects != nil {
+		for _, effect := range *palette.Effects {
+			if effect.Effect != nil {
+				model.Effects = append(model.Effects, types.StringValue(string(*effect.Effect)))
+			}
+		}
+	}
+
+	return model
+}
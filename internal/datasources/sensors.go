@@ -0,0 +1,364 @@
+package datasources
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/openhue/openhue-go"
+	"github.com/ryanolee/terraform-provider-talk/internal/hue"
+)
+
+type SensorsDataSource struct {
+	client *hue.Client
+}
+
+type (
+	SensorsDataSourceModel struct {
+		DeviceName  types.String                  `tfsdk:"device_name"`
+		Temperature []temperatureSensorModel      `tfsdk:"temperature"`
+		LightLevel  []lightLevelSensorModel       `tfsdk:"light_level"`
+		Motion      []motionSensorDataSourceModel `tfsdk:"motion"`
+	}
+
+	temperatureSensorModel struct {
+		Id          types.String  `tfsdk:"id"`
+		DeviceId    types.String  `tfsdk:"device_id"`
+		DeviceName  types.String  `tfsdk:"device_name"`
+		Enabled     types.Bool    `tfsdk:"enabled"`
+		Valid       types.Bool    `tfsdk:"valid"`
+		Temperature types.Float64 `tfsdk:"temperature"`
+		Changed     types.String  `tfsdk:"changed"`
+	}
+
+	lightLevelSensorModel struct {
+		Id         types.String  `tfsdk:"id"`
+		DeviceId   types.String  `tfsdk:"device_id"`
+		DeviceName types.String  `tfsdk:"device_name"`
+		Enabled    types.Bool    `tfsdk:"enabled"`
+		Valid      types.Bool    `tfsdk:"valid"`
+		LightLevel types.Int64   `tfsdk:"light_level"`
+		Lux        types.Float64 `tfsdk:"lux"`
+		Changed    types.String  `tfsdk:"changed"`
+	}
+
+	motionSensorDataSourceModel struct {
+		Id         types.String `tfsdk:"id"`
+		DeviceId   types.String `tfsdk:"device_id"`
+		DeviceName types.String `tfsdk:"device_name"`
+		Enabled    types.Bool   `tfsdk:"enabled"`
+		Valid      types.Bool   `tfsdk:"valid"`
+		Motion     types.Bool   `tfsdk:"motion"`
+		Changed    types.String `tfsdk:"changed"`
+	}
+)
+
+func NewSensorsDataSource() datasource.DataSource {
+	return &SensorsDataSource{}
+}
+
+func (d *SensorsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_sensors", req.ProviderTypeName)
+}
+
+func (d *SensorsDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	sensorAttributes := func(reading map[string]schema.Attribute) map[string]schema.Attribute {
+		attributes := map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the sensor service",
+				Computed:    true,
+			},
+			"device_id": schema.StringAttribute{
+				Description: "The ID of the device that owns the sensor",
+				Computed:    true,
+			},
+			"device_name": schema.StringAttribute{
+				Description: "The name of the device that owns the sensor",
+				Computed:    true,
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the sensor is enabled",
+				Computed:    true,
+			},
+			"valid": schema.BoolAttribute{
+				Description: "Whether the sensor is currently reporting a valid reading",
+				Computed:    true,
+			},
+			"changed": schema.StringAttribute{
+				Description: "When the reading last changed, as an RFC 3339 timestamp",
+				Computed:    true,
+			},
+		}
+
+		for name, attribute := range reading {
+			attributes[name] = attribute
+		}
+
+		return attributes
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"device_name": schema.StringAttribute{
+				Description: "Only return sensors belonging to the device with this name",
+				Optional:    true,
+			},
+			"temperature": schema.ListNestedAttribute{
+				Description: "Temperature sensors",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: sensorAttributes(map[string]schema.Attribute{
+						"temperature": schema.Float64Attribute{
+							Description: "The temperature in degrees celsius",
+							Computed:    true,
+						},
+					}),
+				},
+			},
+			"light_level": schema.ListNestedAttribute{
+				Description: "Light level sensors",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: sensorAttributes(map[string]schema.Attribute{
+						"light_level": schema.Int64Attribute{
+							Description: "The raw light level as reported by the bridge (10000 * log10(lux) + 1)",
+							Computed:    true,
+						},
+						"lux": schema.Float64Attribute{
+							Description: "The light level converted to lux",
+							Computed:    true,
+						},
+					}),
+				},
+			},
+			"motion": schema.ListNestedAttribute{
+				Description: "Motion sensors",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: sensorAttributes(map[string]schema.Attribute{
+						"motion": schema.BoolAttribute{
+							Description: "Whether motion is currently detected",
+							Computed:    true,
+						},
+					}),
+				},
+			},
+		},
+		Description: "The current readings of the temperature, light level and motion sensors in the Hue system",
+	}
+}
+
+func (d *SensorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	if d.client == nil {
+		resp.Diagnostics.AddError("failed to read sensors", "client is nil")
+		return
+	}
+
+	var model SensorsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	devicesResp, err := d.client.GetDevicesWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get devices", fmt.Sprintf("failed to get devices: %s", err.Error()))
+		return
+	}
+
+	if devicesResp.HTTPResponse.StatusCode != http.StatusOK || devicesResp.JSON200 == nil || devicesResp.JSON200.Data == nil {
+		resp.Diagnostics.AddError("failed to get devices", fmt.Sprintf("failed to get devices: %s, %s", devicesResp.HTTPResponse.Status, string(devicesResp.Body)))
+		return
+	}
+
+	deviceNames := map[string]string{}
+	for _, device := range *devicesResp.JSON200.Data {
+		if device.Id != nil && device.Metadata != nil && device.Metadata.Name != nil {
+			deviceNames[*device.Id] = *device.Metadata.Name
+		}
+	}
+
+	// ownerOf resolves the owning device of a sensor, reporting whether it passes the device name filter
+	ownerOf := func(owner *openhue.ResourceIdentifier) (types.String, types.String, bool) {
+		if owner == nil || owner.Rid == nil {
+			return types.StringNull(), types.StringNull(), model.DeviceName.IsNull()
+		}
+
+		name, ok := deviceNames[*owner.Rid]
+		if !ok {
+			return types.StringPointerValue(owner.Rid), types.StringNull(), model.DeviceName.IsNull()
+		}
+
+		return types.StringPointerValue(owner.Rid), types.StringValue(name), model.DeviceName.IsNull() || model.DeviceName.ValueString() == name
+	}
+
+	temperatureResp, err := d.client.GetTemperaturesWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get temperature sensors", fmt.Sprintf("failed to get temperature sensors: %s", err.Error()))
+		return
+	}
+
+	if temperatureResp.HTTPResponse.StatusCode != http.StatusOK || temperatureResp.JSON200 == nil || temperatureResp.JSON200.Data == nil {
+		resp.Diagnostics.AddError("failed to get temperature sensors", fmt.Sprintf("failed to get temperature sensors: %s, %s", temperatureResp.HTTPResponse.Status, string(temperatureResp.Body)))
+		return
+	}
+
+	model.Temperature = []temperatureSensorModel{}
+	for _, sensor := range *temperatureResp.JSON200.Data {
+		deviceId, deviceName, ok := ownerOf(sensor.Owner)
+		if !ok {
+			continue
+		}
+
+		sensorModel := temperatureSensorModel{
+			Id:          types.StringPointerValue(sensor.Id),
+			DeviceId:    deviceId,
+			DeviceName:  deviceName,
+			Enabled:     types.BoolPointerValue(sensor.Enabled),
+			Valid:       types.BoolNull(),
+			Temperature: types.Float64Null(),
+			Changed:     types.StringNull(),
+		}
+
+		if sensor.Temperature != nil {
+			sensorModel.Valid = types.BoolPointerValue(sensor.Temperature.TemperatureValid)
+			if sensor.Temperature.Temperature != nil {
+				sensorModel.Temperature = types.Float64Value(float64(*sensor.Temperature.Temperature))
+			}
+
+			if report := sensor.Temperature.TemperatureReport; report != nil {
+				if report.Temperature != nil {
+					sensorModel.Temperature = types.Float64Value(float64(*report.Temperature))
+				}
+				sensorModel.Changed = formatChanged(report.Changed)
+			}
+		}
+
+		model.Temperature = append(model.Temperature, sensorModel)
+	}
+
+	lightLevelResp, err := d.client.GetLightLevelsWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get light level sensors", fmt.Sprintf("failed to get light level sensors: %s", err.Error()))
+		return
+	}
+
+	if lightLevelResp.HTTPResponse.StatusCode != http.StatusOK || lightLevelResp.JSON200 == nil || lightLevelResp.JSON200.Data == nil {
+		resp.Diagnostics.AddError("failed to get light level sensors", fmt.Sprintf("failed to get light level sensors: %s, %s", lightLevelResp.HTTPResponse.Status, string(lightLevelResp.Body)))
+		return
+	}
+
+	model.LightLevel = []lightLevelSensorModel{}
+	for _, sensor := range *lightLevelResp.JSON200.Data {
+		deviceId, deviceName, ok := ownerOf(sensor.Owner)
+		if !ok {
+			continue
+		}
+
+		sensorModel := lightLevelSensorModel{
+			Id:         types.StringPointerValue(sensor.Id),
+			DeviceId:   deviceId,
+			DeviceName: deviceName,
+			Enabled:    types.BoolPointerValue(sensor.Enabled),
+			Valid:      types.BoolNull(),
+			LightLevel: types.Int64Null(),
+			Lux:        types.Float64Null(),
+			Changed:    types.StringNull(),
+		}
+
+		if sensor.Light != nil {
+			sensorModel.Valid = types.BoolPointerValue(sensor.Light.LightLevelValid)
+			lightLevel := sensor.Light.LightLevel
+
+			if report := sensor.Light.LightLevelReport; report != nil {
+				if report.LightLevel != nil {
+					lightLevel = report.LightLevel
+				}
+				sensorModel.Changed = formatChanged(report.Changed)
+			}
+
+			if lightLevel != nil {
+				sensorModel.LightLevel = types.Int64Value(int64(*lightLevel))
+				sensorModel.Lux = types.Float64Value(math.Pow(10, float64(*lightLevel-1)/10000))
+			}
+		}
+
+		model.LightLevel = append(model.LightLevel, sensorModel)
+	}
+
+	motionResp, err := d.client.GetMotionSensorsWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get motion sensors", fmt.Sprintf("failed to get motion sensors: %s", err.Error()))
+		return
+	}
+
+	if motionResp.HTTPResponse.StatusCode != http.StatusOK || motionResp.JSON200 == nil || motionResp.JSON200.Data == nil {
+		resp.Diagnostics.AddError("failed to get motion sensors", fmt.Sprintf("failed to get motion sensors: %s, %s", motionResp.HTTPResponse.Status, string(motionResp.Body)))
+		return
+	}
+
+	model.Motion = []motionSensorDataSourceModel{}
+	for _, sensor := range *motionResp.JSON200.Data {
+		deviceId, deviceName, ok := ownerOf(sensor.Owner)
+		if !ok {
+			continue
+		}
+
+		sensorModel := motionSensorDataSourceModel{
+			Id:         types.StringPointerValue(sensor.Id),
+			DeviceId:   deviceId,
+			DeviceName: deviceName,
+			Enabled:    types.BoolPointerValue(sensor.Enabled),
+			Valid:      types.BoolNull(),
+			Motion:     types.BoolNull(),
+			Changed:    types.StringNull(),
+		}
+
+		if sensor.Motion != nil {
+			sensorModel.Valid = types.BoolPointerValue(sensor.Motion.MotionValid)
+			sensorModel.Motion = types.BoolPointerValue(sensor.Motion.Motion)
+
+			if report := sensor.Motion.MotionReport; report != nil {
+				if report.Motion != nil {
+					sensorModel.Motion = types.BoolPointerValue(report.Motion)
+				}
+				sensorModel.Changed = types.StringPointerValue(report.Changed)
+			}
+		}
+
+		model.Motion = append(model.Motion, sensorModel)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (d *SensorsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Configure can be called multiple times (sometimes without provider data)
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*hue.Client)
+	if !ok {
+		resp.Diagnostics.AddError("expected hue.Client", fmt.Sprintf("Expected *hue.Client, got %T", req.ProviderData))
+		return
+	}
+
+	d.client = client
+}
+
+func formatChanged(changed *time.Time) types.String {
+	if changed == nil {
+		return types.StringNull()
+	}
+
+	return types.StringValue(changed.Format(time.RFC3339))
+}
@@ -0,0 +1,488 @@
+// Package export generates Terraform configuration for the rooms, lights, scenes and sensors of an
+// existing bridge, so a household set up in the Hue app can be brought under Terraform in one step.
+package export
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/openhue/openhue-go"
+	"github.com/ryanolee/terraform-provider-talk/internal/config"
+	"github.com/ryanolee/terraform-provider-talk/internal/hue"
+	"github.com/ryanolee/terraform-provider-talk/internal/util"
+)
+
+// Run parses the arguments of the export subcommand, reads the bridge and writes the generated
+// configuration to the file given with -out, or to stdout. Usage and progress go to stderr, so that
+// stdout can be redirected to a file.
+func Run(ctx context.Context, args []string, stdout io.Writer, stderr io.Writer) error {
+	flags := flag.NewFlagSet("export", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: terraform-provider-openhue export [flags]")
+		fmt.Fprintln(flags.Output(), "")
+		fmt.Fprintln(flags.Output(), "Writes Terraform configuration with import blocks for the rooms, lights, scenes and sensors of a bridge.")
+		fmt.Fprintln(flags.Output(), "The bridge is found the same way the provider finds it, including HUE_BRIDGE_IP and HUE_BRIDGE_API_KEY.")
+		fmt.Fprintln(flags.Output(), "")
+		flags.PrintDefaults()
+	}
+
+	bridgeIp := flags.String("bridge-ip", "", "The IP address of the bridge, discovered on the local network if not set")
+	bridgeApiKey := flags.String("bridge-api-key", "", "The API key for the bridge, created by pressing the link button if not set")
+	cache := flags.Bool("cache", false, "Whether to read and write the cached bridge IP and API key")
+	out := flags.String("out", "", "The file to write the configuration to, stdout if not set")
+
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil
+		}
+
+		return err
+	}
+
+	authConfig, err := config.GetAuthConfig(ctx, *bridgeIp, *bridgeApiKey, *cache)
+	if err != nil {
+		return fmt.Errorf("failed to get auth config: %w", err)
+	}
+
+	client, err := hue.NewClient(authConfig)
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	configuration, err := Generate(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	if *out == "" {
+		_, err = io.WriteString(stdout, configuration)
+		return err
+	}
+
+	return os.WriteFile(*out, []byte(configuration), 0o644)
+}
+
+// Generate returns the configuration for everything on the bridge the provider can manage
+func Generate(ctx context.Context, client *hue.Client) (string, error) {
+	e := &exporter{
+		client:      client,
+		identifiers: newIdentifiers(),
+		references:  map[string]string{},
+		names:       map[string]string{},
+	}
+
+	sections := []struct {
+		title  string
+		export func(context.Context) ([]hclBlock, error)
+	}{
+		{"Lights are adopted on apply, so they do not need to be imported", e.exportLights},
+		{"Rooms", e.exportRooms},
+		{"Zones", e.exportZones},
+		{"Scenes", e.exportScenes},
+		{"Motion sensors", e.exportMotionSensors},
+		{"CLIP sensors", e.exportClipSensors},
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("# Generated by terraform-provider-openhue export from the bridge at %s.\n", client.AuthConfig.BridgeIp))
+	b.WriteString("# Import blocks need Terraform 1.5 or later. Run terraform plan to review the result before applying it.\n")
+
+	for _, section := range sections {
+		blocks, err := section.export(ctx)
+		if err != nil {
+			return "", err
+		}
+
+		if len(blocks) == 0 {
+			continue
+		}
+
+		b.WriteString(fmt.Sprintf("\n# %s\n\n", section.title))
+		writeBlocks(&b, blocks)
+	}
+
+	return b.String(), nil
+}
+
+type exporter struct {
+	client      *hue.Client
+	identifiers *identifiers
+
+	// references holds an expression for the ID of each exported resource by its ID, so that later
+	// resources can reference it instead of repeating the ID
+	references map[string]string
+
+	// names holds the identifier each exported resource was given by its ID
+	names map[string]string
+}
+
+// add registers a resource block and, if importable, the import block that adopts it
+func (e *exporter) add(blocks []hclBlock, resourceType string, name string, id string, comment string, attributes []hclAttribute, importable bool) []hclBlock {
+	identifier := e.identifiers.next(resourceType, name)
+	address := fmt.Sprintf("%s.%s", resourceType, identifier)
+	e.references[id] = address + ".id"
+	e.names[id] = identifier
+
+	blocks = append(blocks, hclBlock{
+		Comment:    comment,
+		Header:     fmt.Sprintf("resource %q %q", resourceType, identifier),
+		Attributes: attributes,
+	})
+
+	if !importable {
+		return blocks
+	}
+
+	return append(blocks, hclBlock{
+		Header: "import",
+		Attributes: []hclAttribute{
+			{Name: "to", Value: address},
+			{Name: "id", Value: hclString(id)},
+		},
+	})
+}
+
+// reference returns an expression for the ID of a resource, referencing it if it was exported
+func (e *exporter) reference(id string) string {
+	if reference, ok := e.references[id]; ok {
+		return reference
+	}
+
+	return hclString(id)
+}
+
+func (e *exporter) exportLights(ctx context.Context) ([]hclBlock, error) {
+	apiResp, err := e.client.GetLightsWithResponse(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lights: %w", err)
+	}
+
+	if apiResp.HTTPResponse.StatusCode != http.StatusOK || apiResp.JSON200 == nil || apiResp.JSON200.Data == nil {
+		return nil, fmt.Errorf("failed to get lights: %s, %s", apiResp.HTTPResponse.Status, string(apiResp.Body))
+	}
+
+	roomNames, err := e.client.GetDeviceRoomNames(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rooms: %w", err)
+	}
+
+	lights := *apiResp.JSON200.Data
+	sort.SliceStable(lights, func(i, j int) bool {
+		return lightName(&lights[i]) < lightName(&lights[j])
+	})
+
+	blocks := []hclBlock{}
+	for _, light := range lights {
+		if light.Id == nil {
+			continue
+		}
+
+		comment := lightName(&light)
+		if room := hue.LightRoomName(roomNames, &light); room != "" {
+			comment = fmt.Sprintf("%s in %s", comment, room)
+		}
+
+		blocks = e.add(blocks, "openhue_light", lightName(&light), *light.Id, comment, lightAttributes(&light), false)
+	}
+
+	return blocks, nil
+}
+
+func (e *exporter) exportRooms(ctx context.Context) ([]hclBlock, error) {
+	apiResp, err := e.client.GetRoomsWithResponse(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rooms: %w", err)
+	}
+
+	if apiResp.HTTPResponse.StatusCode != http.StatusOK || apiResp.JSON200 == nil || apiResp.JSON200.Data == nil {
+		return nil, fmt.Errorf("failed to get rooms: %s, %s", apiResp.HTTPResponse.Status, string(apiResp.Body))
+	}
+
+	blocks := []hclBlock{}
+	for _, room := range *apiResp.JSON200.Data {
+		if room.Id == nil || room.Metadata == nil || room.Metadata.Name == nil {
+			continue
+		}
+
+		attributes := []hclAttribute{
+			{Name: "name", Value: hclString(*room.Metadata.Name)},
+		}
+
+		// The archetype is required, rooms without one are exported as other
+		archetype := openhue.RoomArchetypeOther
+		if room.Metadata.Archetype != nil {
+			archetype = *room.Metadata.Archetype
+		}
+
+		attributes = append(attributes, hclAttribute{Name: "archetype", Value: hclString(string(archetype))})
+
+		// The lights of a room are the devices the bridge lists as its children. An empty room is read
+		// back with an empty list, so the list is set even then.
+		deviceIds := []string{}
+		if room.Children != nil {
+			for _, child := range *room.Children {
+				if child.Rid != nil {
+					deviceIds = append(deviceIds, *child.Rid)
+				}
+			}
+		}
+
+		attributes = append(attributes, hclAttribute{Name: "lights", Value: hclStringList(deviceIds)})
+
+		blocks = e.add(blocks, "openhue_room", *room.Metadata.Name, *room.Id, "", attributes, true)
+	}
+
+	return blocks, nil
+}
+
+// exportZones lists the zones without exporting them, since the provider has no zone resource yet.
+// Scenes of a zone refer to it by ID.
+func (e *exporter) exportZones(ctx context.Context) ([]hclBlock, error) {
+	apiResp, err := e.client.GetZonesWithResponse(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get zones: %w", err)
+	}
+
+	if apiResp.HTTPResponse.StatusCode != http.StatusOK || apiResp.JSON200 == nil || apiResp.JSON200.Data == nil {
+		return nil, fmt.Errorf("failed to get zones: %s, %s", apiResp.HTTPResponse.Status, string(apiResp.Body))
+	}
+
+	// A locals block keeps the zone IDs at hand without managing the zones themselves
+	attributes := []hclAttribute{}
+	for _, zone := range *apiResp.JSON200.Data {
+		if zone.Id == nil || zone.Metadata == nil || zone.Metadata.Name == nil {
+			continue
+		}
+
+		identifier := e.identifiers.next("local", "zone_"+*zone.Metadata.Name)
+		e.references[*zone.Id] = "local." + identifier
+		e.names[*zone.Id] = identifier
+
+		attributes = append(attributes, hclAttribute{Name: identifier, Value: hclString(*zone.Id)})
+	}
+
+	if len(attributes) == 0 {
+		return nil, nil
+	}
+
+	return []hclBlock{{
+		Comment:    "Zones cannot be managed by the provider yet, so only their IDs are kept",
+		Header:     "locals",
+		Attributes: attributes,
+	}}, nil
+}
+
+func (e *exporter) exportScenes(ctx context.Context) ([]hclBlock, error) {
+	apiResp, err := e.client.GetScenesWithResponse(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scenes: %w", err)
+	}
+
+	if apiResp.HTTPResponse.StatusCode != http.StatusOK || apiResp.JSON200 == nil || apiResp.JSON200.Data == nil {
+		return nil, fmt.Errorf("failed to get scenes: %s, %s", apiResp.HTTPResponse.Status, string(apiResp.Body))
+	}
+
+	blocks := []hclBlock{}
+	for _, scene := range *apiResp.JSON200.Data {
+		if scene.Id == nil || scene.Metadata == nil || scene.Metadata.Name == nil || scene.Group == nil || scene.Group.Rid == nil {
+			continue
+		}
+
+		name := *scene.Metadata.Name
+		attributes := []hclAttribute{
+			{Name: "name", Value: hclString(name)},
+			{Name: "group_id", Value: e.reference(*scene.Group.Rid)},
+		}
+
+		if scene.Group.Rtype != nil && *scene.Group.Rtype == openhue.ResourceIdentifierRtypeZone {
+			attributes = append(attributes, hclAttribute{Name: "group_type", Value: hclString(string(openhue.ResourceIdentifierRtypeZone))})
+		}
+
+		actions := [][]hclAttribute{}
+		if scene.Actions != nil {
+			for _, action := range *scene.Actions {
+				if action.Target == nil || action.Target.Rid == nil {
+					continue
+				}
+
+				actions = append(actions, e.sceneActionAttributes(&action))
+			}
+		}
+
+		attributes = append(attributes, hclAttribute{Name: "actions", Value: hclObjectList(actions)})
+
+		// Scenes with the same name in different rooms are told apart by their room
+		identifierName := name
+		if group, ok := e.names[*scene.Group.Rid]; ok {
+			identifierName = fmt.Sprintf("%s %s", group, name)
+		}
+
+		blocks = e.add(blocks, "openhue_scene", identifierName, *scene.Id, "", attributes, true)
+	}
+
+	return blocks, nil
+}
+
+func (e *exporter) sceneActionAttributes(action *openhue.ActionGet) []hclAttribute {
+	attributes := []hclAttribute{
+		{Name: "target_id", Value: e.reference(*action.Target.Rid)},
+	}
+
+	if action.Action == nil {
+		return attributes
+	}
+
+	if action.Action.On != nil && action.Action.On.On != nil {
+		attributes = append(attributes, hclAttribute{Name: "on", Value: fmt.Sprintf("%t", *action.Action.On.On)})
+	}
+
+	if action.Action.Dimming != nil && action.Action.Dimming.Brightness != nil {
+		attributes = append(attributes, hclAttribute{Name: "brightness", Value: fmt.Sprintf("%g", *action.Action.Dimming.Brightness)})
+	}
+
+	// A color temperature takes precedence, since the resource does not allow both
+	if action.Action.ColorTemperature != nil && action.Action.ColorTemperature.Mirek != nil {
+		attributes = append(attributes, hclAttribute{Name: "color_temperature", Value: fmt.Sprintf("%d", *action.Action.ColorTemperature.Mirek)})
+	} else if action.Action.Color != nil && action.Action.Color.Xy != nil && action.Action.Color.Xy.X != nil && action.Action.Color.Xy.Y != nil {
+		attributes = append(attributes, hclAttribute{Name: "color", Value: hclString(util.XyToHex(*action.Action.Color.Xy.X, *action.Action.Color.Xy.Y))})
+	}
+
+	if action.Action.Effects != nil && action.Action.Effects.Effect != nil {
+		attributes = append(attributes, hclAttribute{Name: "effect", Value: hclString(string(*action.Action.Effects.Effect))})
+	}
+
+	return attributes
+}
+
+func (e *exporter) exportMotionSensors(ctx context.Context) ([]hclBlock, error) {
+	apiResp, err := e.client.GetMotionSensorsWithResponse(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get motion sensors: %w", err)
+	}
+
+	if apiResp.HTTPResponse.StatusCode != http.StatusOK || apiResp.JSON200 == nil || apiResp.JSON200.Data == nil {
+		return nil, fmt.Errorf("failed to get motion sensors: %s, %s", apiResp.HTTPResponse.Status, string(apiResp.Body))
+	}
+
+	deviceNames, err := e.getDeviceNames(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := []hclBlock{}
+	for _, motion := range *apiResp.JSON200.Data {
+		if motion.Id == nil {
+			continue
+		}
+
+		name := *motion.Id
+		if motion.Owner != nil && motion.Owner.Rid != nil && deviceNames[*motion.Owner.Rid] != "" {
+			name = deviceNames[*motion.Owner.Rid]
+		}
+
+		blocks = e.add(blocks, "openhue_motion_sensor", name, *motion.Id, name, []hclAttribute{
+			{Name: "id", Value: hclString(*motion.Id)},
+		}, true)
+	}
+
+	return blocks, nil
+}
+
+func (e *exporter) exportClipSensors(ctx context.Context) ([]hclBlock, error) {
+	var sensors map[string]hue.ClipSensor
+	if err := e.client.V1.Get(ctx, "/sensors", &sensors); err != nil {
+		return nil, fmt.Errorf("failed to get clip sensors: %w", err)
+	}
+
+	ids := make([]string, 0, len(sensors))
+	for id := range sensors {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	blocks := []hclBlock{}
+	for _, id := range ids {
+		sensor := sensors[id]
+		if sensor.Type == nil || sensor.Name == nil {
+			continue
+		}
+
+		if *sensor.Type != hue.ClipSensorTypeGenericFlag && *sensor.Type != hue.ClipSensorTypeGenericStatus {
+			continue
+		}
+
+		blocks = e.add(blocks, "openhue_clip_sensor", *sensor.Name, id, "", []hclAttribute{
+			{Name: "name", Value: hclString(*sensor.Name)},
+			{Name: "type", Value: hclString(*sensor.Type)},
+		}, true)
+	}
+
+	return blocks, nil
+}
+
+// getDeviceNames returns the name of each device, indexed by device ID
+func (e *exporter) getDeviceNames(ctx context.Context) (map[string]string, error) {
+	apiResp, err := e.client.GetDevicesWithResponse(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get devices: %w", err)
+	}
+
+	if apiResp.HTTPResponse.StatusCode != http.StatusOK || apiResp.JSON200 == nil || apiResp.JSON200.Data == nil {
+		return nil, fmt.Errorf("failed to get devices: %s, %s", apiResp.HTTPResponse.Status, string(apiResp.Body))
+	}
+
+	names := map[string]string{}
+	for _, device := range *apiResp.JSON200.Data {
+		if device.Id != nil && device.Metadata != nil && device.Metadata.Name != nil {
+			names[*device.Id] = *device.Metadata.Name
+		}
+	}
+
+	return names, nil
+}
+
+// lightAttributes returns the current state of the light, since adopting a light writes its configured
+// state and the defaults would otherwise switch it off
+func lightAttributes(light *openhue.LightGet) []hclAttribute {
+	attributes := []hclAttribute{
+		{Name: "id", Value: hclString(*light.Id)},
+	}
+
+	if light.On != nil && light.On.On != nil {
+		attributes = append(attributes, hclAttribute{Name: "on", Value: fmt.Sprintf("%t", *light.On.On)})
+	}
+
+	if light.Dimming != nil && light.Dimming.Brightness != nil {
+		attributes = append(attributes, hclAttribute{Name: "brightness", Value: fmt.Sprintf("%g", *light.Dimming.Brightness)})
+	}
+
+	// The bridge reports a valid mirek only while the light shows a color temperature
+	if light.ColorTemperature != nil && light.ColorTemperature.Mirek != nil && light.ColorTemperature.MirekValid != nil && *light.ColorTemperature.MirekValid {
+		attributes = append(attributes, hclAttribute{Name: "color_temperature", Value: fmt.Sprintf("%d", *light.ColorTemperature.Mirek)})
+	}
+
+	if light.Color != nil && light.Color.Xy != nil && light.Color.Xy.X != nil && light.Color.Xy.Y != nil {
+		attributes = append(attributes, hclAttribute{
+			Name:  "color",
+			Value: fmt.Sprintf("{ x = %g, y = %g, z = 0 }", *light.Color.Xy.X, *light.Color.Xy.Y),
+		})
+	}
+
+	return attributes
+}
+
+func lightName(light *openhue.LightGet) string {
+	if light.Metadata == nil || light.Metadata.Name == nil {
+		return ""
+	}
+
+	return *light.Metadata.Name
+}
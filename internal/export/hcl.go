@@ -0,0 +1,141 @@
+package export
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// hclAttribute is an attribute of a block. Value is an HCL expression, which may span several lines.
+type hclAttribute struct {
+	Name  string
+	Value string
+}
+
+// hclBlock is a top level block such as a resource or import block
+type hclBlock struct {
+	Comment    string
+	Header     string
+	Attributes []hclAttribute
+}
+
+var identifierInvalidChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// identifiers hands out unique Terraform resource names derived from the names in the Hue app
+type identifiers struct {
+	used map[string]bool
+}
+
+func newIdentifiers() *identifiers {
+	return &identifiers{used: map[string]bool{}}
+}
+
+// next returns a valid, unused identifier for the given resource type based on name
+func (i *identifiers) next(resourceType string, name string) string {
+	identifier := strings.Trim(identifierInvalidChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if identifier == "" {
+		identifier = "unnamed"
+	}
+
+	// Identifiers must start with a letter or underscore
+	if identifier[0] >= '0' && identifier[0] <= '9' {
+		identifier = "_" + identifier
+	}
+
+	candidate := identifier
+	for n := 2; i.used[resourceType+"."+candidate]; n++ {
+		candidate = fmt.Sprintf("%s_%d", identifier, n)
+	}
+
+	i.used[resourceType+"."+candidate] = true
+	return candidate
+}
+
+// hclString quotes s as an HCL string literal, escaping template sequences
+func hclString(s string) string {
+	quoted := strconv.Quote(s)
+	quoted = strings.ReplaceAll(quoted, "${", "$${")
+	return strings.ReplaceAll(quoted, "%{", "%%{")
+}
+
+func hclStringList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = hclString(value)
+	}
+
+	return fmt.Sprintf("[%s]", strings.Join(quoted, ", "))
+}
+
+// hclObjectList renders a list of objects with one attribute per line
+func hclObjectList(objects [][]hclAttribute) string {
+	var b strings.Builder
+
+	b.WriteString("[\n")
+	for _, object := range objects {
+		b.WriteString("  {\n")
+		writeAttributes(&b, object, "    ")
+		b.WriteString("  },\n")
+	}
+	b.WriteString("]")
+
+	return b.String()
+}
+
+// writeBlocks renders the blocks separated by blank lines
+func writeBlocks(b *strings.Builder, blocks []hclBlock) {
+	for i, block := range blocks {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+
+		if block.Comment != "" {
+			for _, line := range strings.Split(block.Comment, "\n") {
+				b.WriteString(strings.TrimRight("# "+line, " ") + "\n")
+			}
+		}
+
+		b.WriteString(block.Header + " {\n")
+		writeAttributes(b, block.Attributes, "  ")
+		b.WriteString("}\n")
+	}
+}
+
+// writeAttributes renders the attributes the way terraform fmt does: the equals signs of consecutive
+// single line attributes are aligned, and multi line attributes are set apart by a blank line
+func writeAttributes(b *strings.Builder, attributes []hclAttribute, indent string) {
+	for start := 0; start < len(attributes); {
+		if strings.Contains(attributes[start].Value, "\n") {
+			if start > 0 {
+				b.WriteString("\n")
+			}
+
+			lines := strings.Split(attributes[start].Value, "\n")
+			b.WriteString(fmt.Sprintf("%s%s = %s\n", indent, attributes[start].Name, lines[0]))
+			for _, line := range lines[1:] {
+				b.WriteString(indent + line + "\n")
+			}
+
+			start++
+			continue
+		}
+
+		end := start
+		width := 0
+		for end < len(attributes) && !strings.Contains(attributes[end].Value, "\n") {
+			width = max(width, len(attributes[end].Name))
+			end++
+		}
+
+		if start > 0 && strings.Contains(attributes[start-1].Value, "\n") {
+			b.WriteString("\n")
+		}
+
+		for _, attribute := range attributes[start:end] {
+			b.WriteString(fmt.Sprintf("%s%-*s = %s\n", indent, width, attribute.Name, attribute.Value))
+		}
+
+		start = end
+	}
+}
@@ -6,7 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/lucasb-eyer/go-colorful"
+	"github.com/ryanolee/terraform-provider-talk/internal/util"
 )
 
 var _ function.Function = &Hextod65Function{}
@@ -54,17 +54,16 @@ func (f *Hextod65Function) Run(ctx context.Context, req function.RunRequest, res
 
 	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &hexColor))
 
-	color, err := colorful.Hex(hexColor)
+	x, y, z, err := util.HexToXyy(hexColor)
 	if err != nil {
 		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError("failed to convert hex color"))
 		return
 	}
-	x, y, z := color.Xyy()
 
 	returnValue := &Hextod65FunctionReturn{
-		X: float32(x),
-		Y: float32(y),
-		Z: float32(z),
+		X: x,
+		Y: y,
+		Z: z,
 	}
 
 	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, &returnValue))
@@ -0,0 +1,170 @@
+package hue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/openhue/openhue-go"
+	"github.com/ryanolee/terraform-provider-talk/internal/util"
+)
+
+// Statuses a behavior_instance reports while the bridge runs it
+const (
+	BehaviorInstanceStatusInitializing = "initializing"
+	BehaviorInstanceStatusRunning      = "running"
+	BehaviorInstanceStatusDisabled     = "disabled"
+	BehaviorInstanceStatusErrored      = "errored"
+)
+
+// BehaviorScript is a behavior_script resource, the built in automation a behavior_instance runs,
+// such as "Wake up" or "Timers". Scripts are read only.
+type BehaviorScript struct {
+	Id                  *string                 `json:"id,omitempty"`
+	Type                *string                 `json:"type,omitempty"`
+	Description         *string                 `json:"description,omitempty"`
+	ConfigurationSchema json.RawMessage         `json:"configuration_schema,omitempty"`
+	TriggerSchema       json.RawMessage         `json:"trigger_schema,omitempty"`
+	StateSchema         json.RawMessage         `json:"state_schema,omitempty"`
+	Version             *string                 `json:"version,omitempty"`
+	Metadata            *BehaviorScriptMetadata `json:"metadata,omitempty"`
+	SupportedFeatures   []string                `json:"supported_features,omitempty"`
+	MaxNumberInstances  *int                    `json:"max_number_instances,omitempty"`
+}
+
+type BehaviorScriptMetadata struct {
+	Name     *string `json:"name,omitempty"`
+	Category *string `json:"category,omitempty"`
+}
+
+// BehaviorInstance is a behavior_instance resource, an automation created from a behavior_script.
+// The same type is used for reading and writing; read only fields are ignored by the bridge.
+type BehaviorInstance struct {
+	Id            *string                    `json:"id,omitempty"`
+	Type          *string                    `json:"type,omitempty"`
+	ScriptId      *string                    `json:"script_id,omitempty"`
+	Enabled       *bool                      `json:"enabled,omitempty"`
+	Configuration json.RawMessage            `json:"configuration,omitempty"`
+	Dependees     []BehaviorInstanceDependee `json:"dependees,omitempty"`
+	Status        *string                    `json:"status,omitempty"`
+	LastError     *string                    `json:"last_error,omitempty"`
+	Metadata      *BehaviorInstanceMetadata  `json:"metadata,omitempty"`
+	MigratedFrom  *string                    `json:"migrated_from,omitempty"`
+	State         map[string]json.RawMessage `json:"state,omitempty"`
+}
+
+type BehaviorInstanceDependee struct {
+	Type   *string                     `json:"type,omitempty"`
+	Target *openhue.ResourceIdentifier `json:"target,omitempty"`
+	Level  *string                     `json:"level,omitempty"`
+}
+
+type BehaviorInstanceMetadata struct {
+	Name *string `json:"name,omitempty"`
+}
+
+func (c *Client) GetBehaviorScripts(ctx context.Context) ([]BehaviorScript, error) {
+	var data []BehaviorScript
+	if err := c.doResourceRequest(ctx, http.MethodGet, "behavior_script", nil, &data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// FindBehaviorScript returns the script with the given ID or, if id is empty, the script with the
+// given metadata name
+func (c *Client) FindBehaviorScript(ctx context.Context, id string, name string) (*BehaviorScript, error) {
+	scripts, err := c.GetBehaviorScripts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, script := range scripts {
+		scriptName := ""
+		if script.Metadata != nil && script.Metadata.Name != nil {
+			scriptName = *script.Metadata.Name
+		}
+
+		if id != "" && script.Id != nil && *script.Id == id {
+			return &script, nil
+		}
+
+		if id == "" && scriptName == name {
+			return &script, nil
+		}
+
+		names = append(names, scriptName)
+	}
+
+	if id != "" {
+		return nil, fmt.Errorf("no behavior script with id %s", id)
+	}
+
+	if match, ok := util.ClosestMatch(name, names); ok {
+		return nil, fmt.Errorf("no behavior script named %q, did you mean %q?", name, match)
+	}
+
+	return nil, fmt.Errorf("no behavior script named %q, available scripts are: %s", name, strings.Join(names, ", "))
+}
+
+// ValidateConfiguration checks the configuration against the configuration schema of the script: its
+// keys, the types and enum values of its values and nested objects and lists. Keywords beyond those are
+// left for the bridge to check.
+func (s *BehaviorScript) ValidateConfiguration(configuration map[string]json.RawMessage) error {
+	if len(s.ConfigurationSchema) == 0 {
+		return nil
+	}
+
+	var schema behaviorSchema
+	if err := json.Unmarshal(s.ConfigurationSchema, &schema); err != nil {
+		return fmt.Errorf("failed to decode configuration schema: %w", err)
+	}
+
+	value, err := json.Marshal(configuration)
+	if err != nil {
+		return fmt.Errorf("failed to encode configuration: %w", err)
+	}
+
+	validator := &behaviorSchemaValidator{root: &schema}
+	validator.validate(&schema, value, "", 0)
+
+	if len(validator.problems) == 0 {
+		return nil
+	}
+
+	sort.Strings(validator.problems)
+	return fmt.Errorf("%s", strings.Join(validator.problems, "; "))
+}
+
+func (c *Client) GetBehaviorInstance(ctx context.Context, behaviorInstanceId string) (*BehaviorInstance, error) {
+	return getSingleResource[BehaviorInstance](ctx, c, "behavior_instance", behaviorInstanceId)
+}
+
+// CreateBehaviorInstance creates the behavior instance and returns its ID
+func (c *Client) CreateBehaviorInstance(ctx context.Context, behaviorInstance BehaviorInstance) (string, error) {
+	behaviorInstance.Type = util.StringPointer("behavior_instance")
+
+	var created []openhue.ResourceIdentifier
+	if err := c.doResourceRequest(ctx, http.MethodPost, "behavior_instance", behaviorInstance, &created); err != nil {
+		return "", err
+	}
+
+	if len(created) == 0 || created[0].Rid == nil {
+		return "", fmt.Errorf("no data in response body")
+	}
+
+	return *created[0].Rid, nil
+}
+
+func (c *Client) UpdateBehaviorInstance(ctx context.Context, behaviorInstanceId string, behaviorInstance BehaviorInstance) error {
+	return c.doResourceRequest(ctx, http.MethodPut, fmt.Sprintf("behavior_instance/%s", behaviorInstanceId), behaviorInstance, nil)
+}
+
+func (c *Client) DeleteBehaviorInstance(ctx context.Context, behaviorInstanceId string) error {
+	return c.doResourceRequest(ctx, http.MethodDelete, fmt.Sprintf("behavior_instance/%s", behaviorInstanceId), nil, nil)
+}
@@ -0,0 +1,269 @@
+package hue
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ryanolee/terraform-provider-talk/internal/util"
+)
+
+// behaviorSchemaMaxDepth stops schemas that refer to themselves from being followed forever
+const behaviorSchemaMaxDepth = 32
+
+// behaviorSchema is the part of a JSON schema the configuration of a behavior script is checked against.
+// Keywords it does not know are ignored, leaving the bridge to check them.
+type behaviorSchema struct {
+	Ref                  string                     `json:"$ref"`
+	Type                 json.RawMessage            `json:"type"`
+	Enum                 []json.RawMessage          `json:"enum"`
+	Properties           map[string]*behaviorSchema `json:"properties"`
+	Required             []string                   `json:"required"`
+	AdditionalProperties json.RawMessage            `json:"additionalProperties"`
+	Items                *behaviorSchema            `json:"items"`
+	Minimum              *float64                   `json:"minimum"`
+	Maximum              *float64                   `json:"maximum"`
+	AllOf                []*behaviorSchema          `json:"allOf"`
+	AnyOf                []*behaviorSchema          `json:"anyOf"`
+	OneOf                []*behaviorSchema          `json:"oneOf"`
+	Definitions          map[string]*behaviorSchema `json:"definitions"`
+	Defs                 map[string]*behaviorSchema `json:"$defs"`
+}
+
+// behaviorSchemaValidator collects the problems of a value, resolving references against the root schema
+type behaviorSchemaValidator struct {
+	root     *behaviorSchema
+	problems []string
+}
+
+// resolve follows references such as {"$ref": "#/definitions/config"} to the definition they point at
+func (v *behaviorSchemaValidator) resolve(schema *behaviorSchema) *behaviorSchema {
+	for depth := 0; schema != nil && schema.Ref != "" && depth < behaviorSchemaMaxDepth; depth++ {
+		name := schema.Ref[strings.LastIndex(schema.Ref, "/")+1:]
+
+		switch {
+		case v.root.Definitions[name] != nil:
+			schema = v.root.Definitions[name]
+		case v.root.Defs[name] != nil:
+			schema = v.root.Defs[name]
+		default:
+			return nil
+		}
+	}
+
+	return schema
+}
+
+func (v *behaviorSchemaValidator) addProblem(path string, format string, args ...any) {
+	problem := fmt.Sprintf(format, args...)
+	if path != "" {
+		problem = fmt.Sprintf("%s: %s", path, problem)
+	}
+
+	v.problems = append(v.problems, problem)
+}
+
+func (v *behaviorSchemaValidator) validate(schema *behaviorSchema, value json.RawMessage, path string, depth int) {
+	schema = v.resolve(schema)
+	if schema == nil || depth > behaviorSchemaMaxDepth {
+		return
+	}
+
+	kind := jsonKind(value)
+
+	if types := schemaTypes(schema.Type); len(types) > 0 && !schemaAllowsKind(types, kind, value) {
+		v.addProblem(path, "expected %s, got %s", strings.Join(types, " or "), kind)
+		return
+	}
+
+	if len(schema.Enum) > 0 && !enumContains(schema.Enum, value) {
+		allowed := make([]string, len(schema.Enum))
+		for i, option := range schema.Enum {
+			allowed[i] = string(option)
+		}
+
+		v.addProblem(path, "%s is not one of %s", string(value), strings.Join(allowed, ", "))
+	}
+
+	if kind == "number" {
+		var number float64
+		if err := json.Unmarshal(value, &number); err == nil {
+			if schema.Minimum != nil && number < *schema.Minimum {
+				v.addProblem(path, "%g is below the minimum of %g", number, *schema.Minimum)
+			}
+
+			if schema.Maximum != nil && number > *schema.Maximum {
+				v.addProblem(path, "%g is above the maximum of %g", number, *schema.Maximum)
+			}
+		}
+	}
+
+	switch kind {
+	case "object":
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(value, &object); err == nil {
+			v.validateObject(schema, object, path, depth)
+		}
+	case "array":
+		var items []json.RawMessage
+		if err := json.Unmarshal(value, &items); err == nil && schema.Items != nil {
+			for i, item := range items {
+				v.validate(schema.Items, item, fmt.Sprintf("%s[%d]", path, i), depth+1)
+			}
+		}
+	}
+
+	for _, subschema := range schema.AllOf {
+		v.validate(subschema, value, path, depth+1)
+	}
+
+	if len(schema.AnyOf) > 0 && v.countMatches(schema.AnyOf, value, path, depth) == 0 {
+		v.addProblem(path, "does not match any of the allowed schemas")
+	}
+
+	if len(schema.OneOf) > 0 {
+		switch matches := v.countMatches(schema.OneOf, value, path, depth); {
+		case matches == 0:
+			v.addProblem(path, "does not match any of the allowed schemas")
+		case matches > 1:
+			v.addProblem(path, "matches %d of the allowed schemas, but must match exactly one", matches)
+		}
+	}
+}
+
+func (v *behaviorSchemaValidator) validateObject(schema *behaviorSchema, object map[string]json.RawMessage, path string, depth int) {
+	for _, key := range schema.Required {
+		if _, ok := object[key]; !ok {
+			v.addProblem(path, "missing required key %q", key)
+		}
+	}
+
+	keys := make([]string, 0, len(object))
+	for key := range object {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if property, ok := schema.Properties[key]; ok {
+			v.validate(property, object[key], joinSchemaPath(path, key), depth+1)
+			continue
+		}
+
+		// Keys are only unknown when the schema lists its properties and does not allow others
+		if len(schema.Properties) == 0 || bytes.Equal(bytes.TrimSpace(schema.AdditionalProperties), []byte("true")) {
+			continue
+		}
+
+		if jsonKind(schema.AdditionalProperties) == "object" {
+			var additional behaviorSchema
+			if err := json.Unmarshal(schema.AdditionalProperties, &additional); err == nil {
+				v.validate(&additional, object[key], joinSchemaPath(path, key), depth+1)
+			}
+
+			continue
+		}
+
+		known := make([]string, 0, len(schema.Properties))
+		for property := range schema.Properties {
+			known = append(known, property)
+		}
+		sort.Strings(known)
+
+		v.addProblem(path, "unknown key %q, known keys are: %s", key, strings.Join(known, ", "))
+	}
+}
+
+// countMatches returns how many of the alternatives the value is valid against
+func (v *behaviorSchemaValidator) countMatches(alternatives []*behaviorSchema, value json.RawMessage, path string, depth int) int {
+	matches := 0
+	for _, alternative := range alternatives {
+		attempt := &behaviorSchemaValidator{root: v.root}
+		attempt.validate(alternative, value, path, depth+1)
+
+		if len(attempt.problems) == 0 {
+			matches++
+		}
+	}
+
+	return matches
+}
+
+func joinSchemaPath(path string, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
+
+// jsonKind returns the JSON schema type of a value, reporting integers as numbers
+func jsonKind(value json.RawMessage) string {
+	trimmed := bytes.TrimSpace(value)
+	if len(trimmed) == 0 {
+		return "null"
+	}
+
+	switch trimmed[0] {
+	case '{':
+		return "object"
+	case '[':
+		return "array"
+	case '"':
+		return "string"
+	case 't', 'f':
+		return "boolean"
+	case 'n':
+		return "null"
+	default:
+		return "number"
+	}
+}
+
+// schemaTypes reads the type keyword, which is either a single type or a list of types
+func schemaTypes(raw json.RawMessage) []string {
+	if len(raw) == 0 {
+		return nil
+	}
+
+	var single string
+	if err := json.Unmarshal(raw, &single); err == nil {
+		return []string{single}
+	}
+
+	var types []string
+	if err := json.Unmarshal(raw, &types); err == nil {
+		return types
+	}
+
+	return nil
+}
+
+func schemaAllowsKind(types []string, kind string, value json.RawMessage) bool {
+	for _, allowed := range types {
+		if allowed == kind {
+			return true
+		}
+
+		if allowed == "integer" && kind == "number" {
+			var number float64
+			if err := json.Unmarshal(value, &number); err == nil && number == float64(int64(number)) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func enumContains(options []json.RawMessage, value json.RawMessage) bool {
+	for _, option := range options {
+		if util.JsonEqual(string(option), string(value)) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,173 @@
+package hue
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+const testBehaviorConfigurationSchema = `{
+	"$ref": "#/definitions/configuration",
+	"definitions": {
+		"group": {
+			"type": "object",
+			"required": ["rid", "rtype"],
+			"properties": {
+				"rid": {"type": "string"},
+				"rtype": {"enum": ["room", "zone"]}
+			}
+		},
+		"configuration": {
+			"type": "object",
+			"required": ["where"],
+			"properties": {
+				"where": {
+					"type": "array",
+					"items": {
+						"type": "object",
+						"properties": {
+							"group": {"$ref": "#/definitions/group"}
+						}
+					}
+				},
+				"when": {
+					"type": "object",
+					"properties": {
+						"hour": {"type": "integer", "minimum": 0, "maximum": 23}
+					}
+				},
+				"fade": {"anyOf": [{"type": "integer"}, {"type": "null"}]},
+				"style": {"oneOf": [{"type": "number"}, {"type": "integer"}, {"type": "string"}]},
+				"labels": {
+					"type": "object",
+					"properties": {"main": {"type": "string"}},
+					"additionalProperties": {"type": "string"}
+				},
+				"extra": {
+					"type": "object",
+					"properties": {"main": {"type": "string"}},
+					"additionalProperties": true
+				}
+			}
+		}
+	}
+}`
+
+func TestValidateConfiguration(t *testing.T) {
+	script := &BehaviorScript{ConfigurationSchema: json.RawMessage(testBehaviorConfigurationSchema)}
+
+	tests := []struct {
+		name          string
+		configuration string
+		problems      []string
+	}{
+		{
+			name:          "valid",
+			configuration: `{"where": [{"group": {"rid": "a", "rtype": "room"}}], "when": {"hour": 7}, "fade": null}`,
+		},
+		{
+			name:          "missing required key",
+			configuration: `{"when": {"hour": 7}}`,
+			problems:      []string{`missing required key "where"`},
+		},
+		{
+			name:          "unknown key",
+			configuration: `{"where": [], "bogus": 1}`,
+			problems:      []string{`unknown key "bogus"`},
+		},
+		{
+			name:          "type mismatch",
+			configuration: `{"where": {}}`,
+			problems:      []string{"where: expected array, got object"},
+		},
+		{
+			name:          "integer accepts whole numbers",
+			configuration: `{"where": [], "when": {"hour": 7.0}}`,
+		},
+		{
+			name:          "integer rejects fractions",
+			configuration: `{"where": [], "when": {"hour": 7.5}}`,
+			problems:      []string{"when.hour: expected integer, got number"},
+		},
+		{
+			name:          "maximum",
+			configuration: `{"where": [], "when": {"hour": 24}}`,
+			problems:      []string{"when.hour: 24 is above the maximum of 23"},
+		},
+		{
+			name:          "enum through ref in list item",
+			configuration: `{"where": [{"group": {"rid": "a", "rtype": "light"}}]}`,
+			problems:      []string{`where[0].group.rtype: "light" is not one of "room", "zone"`},
+		},
+		{
+			name:          "nested required key through ref",
+			configuration: `{"where": [{"group": {"rtype": "room"}}]}`,
+			problems:      []string{`where[0].group: missing required key "rid"`},
+		},
+		{
+			name:          "additional properties schema",
+			configuration: `{"where": [], "labels": {"main": "a", "other": 1}}`,
+			problems:      []string{"labels.other: expected string, got number"},
+		},
+		{
+			name:          "additional properties allowed",
+			configuration: `{"where": [], "extra": {"main": "a", "other": 1}}`,
+		},
+		{
+			name:          "any of without a match",
+			configuration: `{"where": [], "fade": "slow"}`,
+			problems:      []string{"fade: does not match any of the allowed schemas"},
+		},
+		{
+			name:          "one of with a single match",
+			configuration: `{"where": [], "style": "bright"}`,
+		},
+		{
+			name:          "one of with several matches",
+			configuration: `{"where": [], "style": 3}`,
+			problems:      []string{"style: matches 2 of the allowed schemas, but must match exactly one"},
+		},
+		{
+			name:          "one of without a match",
+			configuration: `{"where": [], "style": true}`,
+			problems:      []string{"style: does not match any of the allowed schemas"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var configuration map[string]json.RawMessage
+			if err := json.Unmarshal([]byte(test.configuration), &configuration); err != nil {
+				t.Fatalf("invalid test configuration: %s", err)
+			}
+
+			err := script.ValidateConfiguration(configuration)
+
+			if len(test.problems) == 0 {
+				if err != nil {
+					t.Fatalf("expected no error, got %q", err)
+				}
+
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected an error containing %q, got none", test.problems)
+			}
+
+			for _, problem := range test.problems {
+				if !strings.Contains(err.Error(), problem) {
+					t.Errorf("expected %q to contain %q", err.Error(), problem)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateConfigurationWithoutSchema(t *testing.T) {
+	script := &BehaviorScript{}
+
+	if err := script.ValidateConfiguration(map[string]json.RawMessage{"anything": json.RawMessage(`1`)}); err != nil {
+		t.Fatalf("expected no error without a schema, got %q", err)
+	}
+}
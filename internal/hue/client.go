@@ -0,0 +1,53 @@
+package hue
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net/http"
+
+	"github.com/openhue/openhue-go"
+	"github.com/ryanolee/terraform-provider-talk/internal/config"
+)
+
+// Client is handed to every resource and data source by the provider. It embeds the
+// generated openhue client so API calls can be made on it directly, and keeps hold of the
+// auth config that was used to connect to the bridge.
+type Client struct {
+	*openhue.ClientWithResponses
+
+	AuthConfig *config.AuthConfig
+
+	// V1 is a client for the legacy CLIP v1 API, for the features CLIP v2 does not offer
+	V1 *V1Client
+}
+
+func NewClient(authConfig *config.AuthConfig) (*Client, error) {
+	httpClient := newHttpClient()
+
+	client, err := openhue.NewClientWithResponses(fmt.Sprintf("https://%s", authConfig.BridgeIp), openhue.WithHTTPClient(httpClient), openhue.WithRequestEditorFn(
+		func(ctx context.Context, req *http.Request) error {
+			req.Header.Set("hue-application-key", authConfig.BridgeApiKey)
+			return nil
+		},
+	))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		ClientWithResponses: client,
+		AuthConfig:          authConfig,
+		V1:                  newV1Client(authConfig, httpClient),
+	}, nil
+}
+
+// newHttpClient returns the HTTP client shared by the v1 and v2 APIs. The bridge serves a self-signed
+// certificate, so certificate verification is skipped.
+func newHttpClient() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+
+	return &http.Client{Transport: transport}
+}
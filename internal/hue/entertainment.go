@@ -0,0 +1,162 @@
+package hue
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/openhue/openhue-go"
+	"github.com/ryanolee/terraform-provider-talk/internal/util"
+)
+
+// Configuration types of an entertainment area
+const (
+	EntertainmentConfigurationTypeScreen  = "screen"
+	EntertainmentConfigurationTypeMonitor = "monitor"
+	EntertainmentConfigurationTypeMusic   = "music"
+	EntertainmentConfigurationType3dSpace = "3dspace"
+	EntertainmentConfigurationTypeOther   = "other"
+)
+
+// Entertainment is an entertainment service, which a light capable of streaming exposes next to its light service
+type Entertainment struct {
+	Id                *string                     `json:"id,omitempty"`
+	Owner             *openhue.ResourceIdentifier `json:"owner,omitempty"`
+	Renderer          *bool                       `json:"renderer,omitempty"`
+	RendererReference *openhue.ResourceIdentifier `json:"renderer_reference,omitempty"`
+	Proxy             *bool                       `json:"proxy,omitempty"`
+	MaxStreams        *int                        `json:"max_streams,omitempty"`
+}
+
+// EntertainmentConfiguration is an entertainment_configuration resource, an entertainment area of the
+// Hue Sync app and sync boxes. The same type is used for reading and writing; read only fields are ignored by the bridge.
+type EntertainmentConfiguration struct {
+	Id                *string                              `json:"id,omitempty"`
+	Type              *string                              `json:"type,omitempty"`
+	Metadata          *EntertainmentConfigurationMetadata  `json:"metadata,omitempty"`
+	ConfigurationType *string                              `json:"configuration_type,omitempty"`
+	Status            *string                              `json:"status,omitempty"`
+	Locations         *EntertainmentConfigurationLocations `json:"locations,omitempty"`
+	Channels          []EntertainmentChannel               `json:"channels,omitempty"`
+	LightServices     []openhue.ResourceIdentifier         `json:"light_services,omitempty"`
+}
+
+type EntertainmentConfigurationMetadata struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type EntertainmentConfigurationLocations struct {
+	ServiceLocations []EntertainmentServiceLocation `json:"service_locations"`
+}
+
+// EntertainmentServiceLocation places an entertainment service in the area. Lights with several
+// segments, such as gradient strips, can have more than one position.
+type EntertainmentServiceLocation struct {
+	Service            openhue.ResourceIdentifier `json:"service"`
+	Positions          []EntertainmentPosition    `json:"positions"`
+	EqualizationFactor *float64                   `json:"equalization_factor,omitempty"`
+}
+
+// EntertainmentPosition is a position in the area, each axis ranging from -1 to 1
+type EntertainmentPosition struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Z float64 `json:"z"`
+}
+
+// EntertainmentChannel is a channel the bridge derived from the service locations
+type EntertainmentChannel struct {
+	ChannelId int                          `json:"channel_id"`
+	Position  EntertainmentPosition        `json:"position"`
+	Members   []EntertainmentChannelMember `json:"members"`
+}
+
+type EntertainmentChannelMember struct {
+	Service openhue.ResourceIdentifier `json:"service"`
+	Index   int                        `json:"index"`
+}
+
+func (c *Client) GetEntertainments(ctx context.Context) ([]Entertainment, error) {
+	var data []Entertainment
+	if err := c.doResourceRequest(ctx, http.MethodGet, "entertainment", nil, &data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// GetEntertainmentServicesByLight returns the ID of the entertainment service of each light that can
+// stream, indexed by light ID. Bridges that do not report the light an entertainment service renders
+// to are matched through the device that owns both services.
+func (c *Client) GetEntertainmentServicesByLight(ctx context.Context) (map[string]string, error) {
+	entertainments, err := c.GetEntertainments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiResp, err := c.GetLightsWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if apiResp.HTTPResponse.StatusCode != http.StatusOK || apiResp.JSON200 == nil || apiResp.JSON200.Data == nil {
+		return nil, fmt.Errorf("%s, %s", apiResp.HTTPResponse.Status, string(apiResp.Body))
+	}
+
+	lightsByOwner := map[string]string{}
+	for _, light := range *apiResp.JSON200.Data {
+		if light.Id != nil {
+			lightsByOwner[lightOwnerId(&light)] = *light.Id
+		}
+	}
+
+	services := map[string]string{}
+	for _, entertainment := range entertainments {
+		if entertainment.Id == nil || entertainment.Renderer == nil || !*entertainment.Renderer {
+			continue
+		}
+
+		if entertainment.RendererReference != nil && entertainment.RendererReference.Rid != nil {
+			services[*entertainment.RendererReference.Rid] = *entertainment.Id
+			continue
+		}
+
+		if entertainment.Owner == nil || entertainment.Owner.Rid == nil {
+			continue
+		}
+
+		if lightId, ok := lightsByOwner[*entertainment.Owner.Rid]; ok {
+			services[lightId] = *entertainment.Id
+		}
+	}
+
+	return services, nil
+}
+
+func (c *Client) GetEntertainmentConfiguration(ctx context.Context, entertainmentConfigurationId string) (*EntertainmentConfiguration, error) {
+	return getSingleResource[EntertainmentConfiguration](ctx, c, "entertainment_configuration", entertainmentConfigurationId)
+}
+
+// CreateEntertainmentConfiguration creates the entertainment configuration and returns its ID
+func (c *Client) CreateEntertainmentConfiguration(ctx context.Context, entertainmentConfiguration EntertainmentConfiguration) (string, error) {
+	entertainmentConfiguration.Type = util.StringPointer("entertainment_configuration")
+
+	var created []openhue.ResourceIdentifier
+	if err := c.doResourceRequest(ctx, http.MethodPost, "entertainment_configuration", entertainmentConfiguration, &created); err != nil {
+		return "", err
+	}
+
+	if len(created) == 0 || created[0].Rid == nil {
+		return "", fmt.Errorf("no data in response body")
+	}
+
+	return *created[0].Rid, nil
+}
+
+func (c *Client) UpdateEntertainmentConfiguration(ctx context.Context, entertainmentConfigurationId string, entertainmentConfiguration EntertainmentConfiguration) error {
+	return c.doResourceRequest(ctx, http.MethodPut, fmt.Sprintf("entertainment_configuration/%s", entertainmentConfigurationId), entertainmentConfiguration, nil)
+}
+
+func (c *Client) DeleteEntertainmentConfiguration(ctx context.Context, entertainmentConfigurationId string) error {
+	return c.doResourceRequest(ctx, http.MethodDelete, fmt.Sprintf("entertainment_configuration/%s", entertainmentConfigurationId), nil, nil)
+}
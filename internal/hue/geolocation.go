@@ -0,0 +1,85 @@
+package hue
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/openhue/openhue-go"
+	"github.com/ryanolee/terraform-provider-talk/internal/util"
+)
+
+// Geolocation is the geolocation resource of the bridge, which sunrise and sunset times are derived from.
+// The bridge never reports the coordinates back, only whether they are set.
+type Geolocation struct {
+	Id           *string              `json:"id,omitempty"`
+	Type         *string              `json:"type,omitempty"`
+	IsConfigured *bool                `json:"is_configured,omitempty"`
+	SunToday     *GeolocationSunToday `json:"sun_today,omitempty"`
+	Latitude     *float64             `json:"latitude,omitempty"`
+	Longitude    *float64             `json:"longitude,omitempty"`
+}
+
+type GeolocationSunToday struct {
+	SunsetTime *string `json:"sunset_time,omitempty"`
+	DayType    *string `json:"day_type,omitempty"`
+}
+
+// GeofenceClient is a geofence_client resource, a phone or other device that reports whether it is
+// at home. The bridge never reports is_at_home back. The same type is used for reading and writing.
+type GeofenceClient struct {
+	Id       *string `json:"id,omitempty"`
+	Type     *string `json:"type,omitempty"`
+	Name     *string `json:"name,omitempty"`
+	IsAtHome *bool   `json:"is_at_home,omitempty"`
+}
+
+// GetGeolocation returns the geolocation of the bridge, of which there is exactly one
+func (c *Client) GetGeolocation(ctx context.Context) (*Geolocation, error) {
+	var data []Geolocation
+	if err := c.doResourceRequest(ctx, http.MethodGet, "geolocation", nil, &data); err != nil {
+		return nil, err
+	}
+
+	if len(data) == 0 {
+		return nil, fmt.Errorf("no data in response body")
+	}
+
+	return &data[0], nil
+}
+
+// UpdateGeolocation sets the coordinates of the bridge
+func (c *Client) UpdateGeolocation(ctx context.Context, geolocationId string, latitude float64, longitude float64) error {
+	return c.doResourceRequest(ctx, http.MethodPut, fmt.Sprintf("geolocation/%s", geolocationId), Geolocation{
+		Latitude:  &latitude,
+		Longitude: &longitude,
+	}, nil)
+}
+
+func (c *Client) GetGeofenceClient(ctx context.Context, geofenceClientId string) (*GeofenceClient, error) {
+	return getSingleResource[GeofenceClient](ctx, c, "geofence_client", geofenceClientId)
+}
+
+// CreateGeofenceClient creates the geofence client and returns its ID
+func (c *Client) CreateGeofenceClient(ctx context.Context, geofenceClient GeofenceClient) (string, error) {
+	geofenceClient.Type = util.StringPointer("geofence_client")
+
+	var created []openhue.ResourceIdentifier
+	if err := c.doResourceRequest(ctx, http.MethodPost, "geofence_client", geofenceClient, &created); err != nil {
+		return "", err
+	}
+
+	if len(created) == 0 || created[0].Rid == nil {
+		return "", fmt.Errorf("no data in response body")
+	}
+
+	return *created[0].Rid, nil
+}
+
+func (c *Client) UpdateGeofenceClient(ctx context.Context, geofenceClientId string, geofenceClient GeofenceClient) error {
+	return c.doResourceRequest(ctx, http.MethodPut, fmt.Sprintf("geofence_client/%s", geofenceClientId), geofenceClient, nil)
+}
+
+func (c *Client) DeleteGeofenceClient(ctx context.Context, geofenceClientId string) error {
+	return c.doResourceRequest(ctx, http.MethodDelete, fmt.Sprintf("geofence_client/%s", geofenceClientId), nil, nil)
+}
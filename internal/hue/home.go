@@ -0,0 +1,71 @@
+package hue
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/openhue/openhue-go"
+)
+
+// HomeResource holds the fields shared by the resource types that make up the topology of a home.
+// Fields a type does not have are left empty.
+type HomeResource struct {
+	Id          *string                      `json:"id,omitempty"`
+	Type        *string                      `json:"type,omitempty"`
+	Owner       *openhue.ResourceIdentifier  `json:"owner,omitempty"`
+	Metadata    *HomeResourceMetadata        `json:"metadata,omitempty"`
+	ProductData *HomeResourceProductData     `json:"product_data,omitempty"`
+	Children    []openhue.ResourceIdentifier `json:"children,omitempty"`
+	Services    []openhue.ResourceIdentifier `json:"services,omitempty"`
+	Group       *openhue.ResourceIdentifier  `json:"group,omitempty"`
+}
+
+type HomeResourceMetadata struct {
+	Name      *string `json:"name,omitempty"`
+	Archetype *string `json:"archetype,omitempty"`
+}
+
+type HomeResourceProductData struct {
+	ModelId     *string `json:"model_id,omitempty"`
+	ProductName *string `json:"product_name,omitempty"`
+}
+
+// Home is every resource of the bridge, indexed by ID and by type
+type Home struct {
+	Resources map[string]HomeResource
+	ByType    map[string][]HomeResource
+}
+
+// GetHome fetches every resource of the bridge in a single request
+func (c *Client) GetHome(ctx context.Context) (*Home, error) {
+	var data []HomeResource
+	if err := c.doResourceRequest(ctx, http.MethodGet, "", nil, &data); err != nil {
+		return nil, err
+	}
+
+	home := &Home{
+		Resources: map[string]HomeResource{},
+		ByType:    map[string][]HomeResource{},
+	}
+
+	for _, resource := range data {
+		if resource.Id == nil || resource.Type == nil {
+			continue
+		}
+
+		home.Resources[*resource.Id] = resource
+		home.ByType[*resource.Type] = append(home.ByType[*resource.Type], resource)
+	}
+
+	return home, nil
+}
+
+// Resource returns the resource the identifier points at, if the bridge has it
+func (h *Home) Resource(identifier openhue.ResourceIdentifier) (HomeResource, bool) {
+	if identifier.Rid == nil {
+		return HomeResource{}, false
+	}
+
+	resource, ok := h.Resources[*identifier.Rid]
+	return resource, ok
+}
@@ -0,0 +1,61 @@
+package hue
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/openhue/openhue-go"
+)
+
+// LightPowerup is the power-on behavior of a light. The generated client models the powerup dimming
+// as a bare brightness and nests color inside dimming, neither of which the bridge accepts, so
+// powerup is read and written through these types instead.
+type LightPowerup struct {
+	Preset     *string              `json:"preset,omitempty"`
+	Configured *bool                `json:"configured,omitempty"`
+	On         *LightPowerupOn      `json:"on,omitempty"`
+	Dimming    *LightPowerupDimming `json:"dimming,omitempty"`
+	Color      *LightPowerupColor   `json:"color,omitempty"`
+}
+
+type LightPowerupOn struct {
+	Mode *string     `json:"mode,omitempty"`
+	On   *openhue.On `json:"on,omitempty"`
+}
+
+type LightPowerupDimming struct {
+	Mode    *string          `json:"mode,omitempty"`
+	Dimming *openhue.Dimming `json:"dimming,omitempty"`
+}
+
+type LightPowerupColor struct {
+	Mode             *string                   `json:"mode,omitempty"`
+	ColorTemperature *openhue.ColorTemperature `json:"color_temperature,omitempty"`
+	Color            *openhue.Color            `json:"color,omitempty"`
+}
+
+func (c *Client) GetLightPowerup(ctx context.Context, lightId string) (*LightPowerup, error) {
+	light, err := getSingleResource[struct {
+		Powerup *LightPowerup `json:"powerup,omitempty"`
+	}](ctx, c, "light", lightId)
+	if err != nil {
+		return nil, err
+	}
+
+	if light.Powerup == nil {
+		return nil, fmt.Errorf("light %s does not report a powerup configuration", lightId)
+	}
+
+	return light.Powerup, nil
+}
+
+func (c *Client) UpdateLightPowerup(ctx context.Context, lightId string, powerup LightPowerup) error {
+	body := struct {
+		Powerup LightPowerup `json:"powerup"`
+	}{
+		Powerup: powerup,
+	}
+
+	return c.doResourceRequest(ctx, http.MethodPut, fmt.Sprintf("light/%s", lightId), body, nil)
+}
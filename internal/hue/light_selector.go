@@ -0,0 +1,250 @@
+package hue
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/openhue/openhue-go"
+	"github.com/ryanolee/terraform-provider-talk/internal/util"
+)
+
+// Ways the name and room of a LightSelector are compared against the bridge
+const (
+	LightMatchExact           = "exact"
+	LightMatchCaseInsensitive = "case_insensitive"
+	LightMatchRegex           = "regex"
+)
+
+// LightSelector picks a single light out of the lights known to the bridge. Empty fields are ignored
+// and every non-empty field must match for a light to be selected.
+type LightSelector struct {
+	Id    string
+	Name  string
+	Room  string
+	Match string
+}
+
+// LightNotFoundError is returned when no light matches a LightSelector
+type LightNotFoundError struct {
+	Selector  LightSelector
+	Available []string
+}
+
+func (e *LightNotFoundError) Error() string {
+	description := e.Selector.String()
+
+	if e.Selector.Name != "" && e.Selector.Match != LightMatchRegex {
+		if match, ok := util.ClosestMatch(e.Selector.Name, e.Available); ok {
+			return fmt.Sprintf("%s not found, did you mean \"%s\"? Available lamps %s", description, match, strings.Join(e.Available, ", "))
+		}
+	}
+
+	return fmt.Sprintf("%s not found. Available lamps %s", description, strings.Join(e.Available, ", "))
+}
+
+// AmbiguousLightError is returned when more than one light matches a LightSelector
+type AmbiguousLightError struct {
+	Selector   LightSelector
+	Candidates []LightCandidate
+}
+
+// LightCandidate describes a light that matched an ambiguous LightSelector
+type LightCandidate struct {
+	Id   string
+	Name string
+	Room string
+}
+
+func (e *AmbiguousLightError) Error() string {
+	candidates := make([]string, len(e.Candidates))
+	for i, candidate := range e.Candidates {
+		room := candidate.Room
+		if room == "" {
+			room = "no room"
+		}
+
+		candidates[i] = fmt.Sprintf("%s (id %s, room %s)", candidate.Name, candidate.Id, room)
+	}
+
+	return fmt.Sprintf("%s matches %d lights, set room or id to pick one: %s", e.Selector.String(), len(e.Candidates), strings.Join(candidates, "; "))
+}
+
+func (s LightSelector) String() string {
+	parts := []string{}
+
+	if s.Name != "" {
+		parts = append(parts, fmt.Sprintf("light \"%s\"", s.Name))
+	} else {
+		parts = append(parts, "light")
+	}
+
+	if s.Id != "" {
+		parts = append(parts, fmt.Sprintf("with id \"%s\"", s.Id))
+	}
+
+	if s.Room != "" {
+		parts = append(parts, fmt.Sprintf("in room \"%s\"", s.Room))
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// Validate checks that the selector selects something and that its patterns compile
+func (s LightSelector) Validate() error {
+	if s.Id == "" && s.Name == "" {
+		return fmt.Errorf("at least one of name or id must be set")
+	}
+
+	if s.Match != LightMatchRegex {
+		return nil
+	}
+
+	for _, pattern := range []string{s.Name, s.Room} {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("invalid regex \"%s\": %w", pattern, err)
+		}
+	}
+
+	return nil
+}
+
+// MatchesName reports whether a light name matches the selector's name using its match mode
+func (s LightSelector) MatchesName(name string) bool {
+	return s.matches(s.Name, name)
+}
+
+func (s LightSelector) matches(pattern string, value string) bool {
+	if pattern == "" {
+		return true
+	}
+
+	switch s.Match {
+	case LightMatchCaseInsensitive:
+		return strings.EqualFold(pattern, value)
+	case LightMatchRegex:
+		matched, err := regexp.MatchString(pattern, value)
+		return err == nil && matched
+	default:
+		return pattern == value
+	}
+}
+
+// FindLight returns the only light matching the selector, failing with a LightNotFoundError
+// or AmbiguousLightError if there is not exactly one
+func (c *Client) FindLight(ctx context.Context, selector LightSelector) (*openhue.LightGet, error) {
+	if err := selector.Validate(); err != nil {
+		return nil, err
+	}
+
+	apiResp, err := c.GetLightsWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if apiResp.HTTPResponse.StatusCode != http.StatusOK || apiResp.JSON200 == nil || apiResp.JSON200.Data == nil {
+		return nil, fmt.Errorf("%s, %s", apiResp.HTTPResponse.Status, string(apiResp.Body))
+	}
+
+	lights := *apiResp.JSON200.Data
+
+	var roomNames map[string]string
+	if selector.Room != "" {
+		if roomNames, err = c.GetDeviceRoomNames(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	candidates := []openhue.LightGet{}
+	for _, light := range lights {
+		if selector.Id != "" && (light.Id == nil || *light.Id != selector.Id) {
+			continue
+		}
+
+		if selector.Name != "" && (light.Metadata == nil || light.Metadata.Name == nil || !selector.MatchesName(*light.Metadata.Name)) {
+			continue
+		}
+
+		if selector.Room != "" && !selector.matches(selector.Room, LightRoomName(roomNames, &light)) {
+			continue
+		}
+
+		candidates = append(candidates, light)
+	}
+
+	switch len(candidates) {
+	case 0:
+		lampNames := make([]string, len(lights))
+		for i, light := range lights {
+			lampNames[i] = *light.Metadata.Name
+		}
+
+		return nil, &LightNotFoundError{Selector: selector, Available: lampNames}
+	case 1:
+		return &candidates[0], nil
+	}
+
+	if roomNames == nil {
+		if roomNames, err = c.GetDeviceRoomNames(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	ambiguousErr := &AmbiguousLightError{Selector: selector}
+	for _, light := range candidates {
+		ambiguousErr.Candidates = append(ambiguousErr.Candidates, LightCandidate{
+			Id:   *light.Id,
+			Name: *light.Metadata.Name,
+			Room: LightRoomName(roomNames, &light),
+		})
+	}
+
+	sort.Slice(ambiguousErr.Candidates, func(i, j int) bool {
+		return ambiguousErr.Candidates[i].Id < ambiguousErr.Candidates[j].Id
+	})
+
+	return nil, ambiguousErr
+}
+
+// GetDeviceRoomNames returns the name of the room each device is in, indexed by device ID
+func (c *Client) GetDeviceRoomNames(ctx context.Context) (map[string]string, error) {
+	apiResp, err := c.GetRoomsWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if apiResp.HTTPResponse.StatusCode != http.StatusOK || apiResp.JSON200 == nil || apiResp.JSON200.Data == nil {
+		return nil, fmt.Errorf("%s, %s", apiResp.HTTPResponse.Status, string(apiResp.Body))
+	}
+
+	roomNames := map[string]string{}
+	for _, room := range *apiResp.JSON200.Data {
+		if room.Children == nil || room.Metadata == nil || room.Metadata.Name == nil {
+			continue
+		}
+
+		for _, child := range *room.Children {
+			if child.Rid != nil {
+				roomNames[*child.Rid] = *room.Metadata.Name
+			}
+		}
+	}
+
+	return roomNames, nil
+}
+
+// LightRoomName returns the name of the room the light's device is in, or an empty string if it is not in one
+func LightRoomName(roomNames map[string]string, light *openhue.LightGet) string {
+	return roomNames[lightOwnerId(light)]
+}
+
+func lightOwnerId(light *openhue.LightGet) string {
+	if light.Owner == nil || light.Owner.Rid == nil {
+		return ""
+	}
+
+	return *light.Owner.Rid
+}
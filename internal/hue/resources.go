@@ -0,0 +1,125 @@
+package hue
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/openhue/openhue-go"
+)
+
+// ApiError is returned when the bridge responds to a raw request with an unexpected status code
+type ApiError struct {
+	StatusCode int
+	Status     string
+	Body       string
+}
+
+func (e *ApiError) Error() string {
+	return fmt.Sprintf("%s, %s", e.Status, e.Body)
+}
+
+// IsNotFound reports whether err is an ApiError or V1Error for a resource that does not exist
+func IsNotFound(err error) bool {
+	if apiErr, ok := err.(*ApiError); ok {
+		return apiErr.StatusCode == http.StatusNotFound
+	}
+
+	v1Err, ok := err.(*V1Error)
+	return ok && v1Err.Type == V1ErrorResourceNotAvailable
+}
+
+// doResourceRequest performs a request against the CLIP v2 resource API. It is used for the resource
+// types the generated openhue client does not cover, decoding the "data" field of the response into out.
+func (c *Client) doResourceRequest(ctx context.Context, method string, resourcePath string, body any, out any) error {
+	apiClient, ok := c.ClientInterface.(*openhue.Client)
+	if !ok {
+		return fmt.Errorf("expected *openhue.Client, got %T", c.ClientInterface)
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request body: %w", err)
+		}
+		reqBody = bytes.NewReader(encoded)
+	}
+
+	// An empty path requests every resource of the bridge at once
+	url := fmt.Sprintf("%sclip/v2/resource", apiClient.Server)
+	if resourcePath != "" {
+		url = fmt.Sprintf("%s/%s", url, resourcePath)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
+	if err != nil {
+		return err
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	for _, editor := range apiClient.RequestEditors {
+		if err := editor(ctx, req); err != nil {
+			return err
+		}
+	}
+
+	httpResp, err := apiClient.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	switch httpResp.StatusCode {
+	case http.StatusOK, http.StatusCreated, http.StatusMultiStatus:
+	default:
+		return &ApiError{StatusCode: httpResp.StatusCode, Status: httpResp.Status, Body: string(respBody)}
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	var envelope struct {
+		Data json.RawMessage `json:"data"`
+	}
+
+	if err := json.Unmarshal(respBody, &envelope); err != nil {
+		return fmt.Errorf("failed to decode response body: %w", err)
+	}
+
+	if len(envelope.Data) == 0 {
+		return fmt.Errorf("no data in response body")
+	}
+
+	if err := json.Unmarshal(envelope.Data, out); err != nil {
+		return fmt.Errorf("failed to decode response data: %w", err)
+	}
+
+	return nil
+}
+
+// getSingleResource fetches the resource of the given type and ID, failing if the bridge returns no data
+func getSingleResource[T any](ctx context.Context, c *Client, resourceType string, id string) (*T, error) {
+	var data []T
+	if err := c.doResourceRequest(ctx, http.MethodGet, fmt.Sprintf("%s/%s", resourceType, id), nil, &data); err != nil {
+		return nil, err
+	}
+
+	if len(data) == 0 {
+		return nil, fmt.Errorf("no data in response body")
+	}
+
+	return &data[0], nil
+}
@@ -0,0 +1,102 @@
+package hue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Statuses of a v1 rule. The bridge reports resourcedeleted for rules that reference a deleted resource.
+const (
+	RuleStatusEnabled         = "enabled"
+	RuleStatusDisabled        = "disabled"
+	RuleStatusResourceDeleted = "resourcedeleted"
+)
+
+// Operators of a v1 rule condition
+const (
+	RuleOperatorEq        = "eq"
+	RuleOperatorGt        = "gt"
+	RuleOperatorLt        = "lt"
+	RuleOperatorDx        = "dx"
+	RuleOperatorDdx       = "ddx"
+	RuleOperatorStable    = "stable"
+	RuleOperatorNotStable = "not stable"
+	RuleOperatorIn        = "in"
+	RuleOperatorNotIn     = "not in"
+)
+
+// RuleOperators lists all operators of a v1 rule condition
+var RuleOperators = []string{
+	RuleOperatorEq,
+	RuleOperatorGt,
+	RuleOperatorLt,
+	RuleOperatorDx,
+	RuleOperatorDdx,
+	RuleOperatorStable,
+	RuleOperatorNotStable,
+	RuleOperatorIn,
+	RuleOperatorNotIn,
+}
+
+// RuleOperatorTakesValue reports whether the operator compares against a value. The change operators
+// (dx, ddx, stable and not stable) must be sent without one.
+func RuleOperatorTakesValue(operator string) bool {
+	switch operator {
+	case RuleOperatorDx, RuleOperatorDdx, RuleOperatorStable, RuleOperatorNotStable:
+		return false
+	default:
+		return true
+	}
+}
+
+// Rule is a v1 rule, which runs its actions once all of its conditions are met.
+// The same type is used for reading and writing; read only fields are ignored by the bridge.
+type Rule struct {
+	Name           *string         `json:"name,omitempty"`
+	Status         *string         `json:"status,omitempty"`
+	Conditions     []RuleCondition `json:"conditions,omitempty"`
+	Actions        []RuleAction    `json:"actions,omitempty"`
+	Owner          *string         `json:"owner,omitempty"`
+	Created        *string         `json:"created,omitempty"`
+	Lasttriggered  *string         `json:"lasttriggered,omitempty"`
+	Timestriggered *int            `json:"timestriggered,omitempty"`
+}
+
+// RuleCondition compares the attribute at Address, for example /sensors/2/state/buttonevent.
+// Value is left out for the change operators.
+type RuleCondition struct {
+	Address  string  `json:"address"`
+	Operator string  `json:"operator"`
+	Value    *string `json:"value,omitempty"`
+}
+
+// RuleAction is a request the rule sends when it fires. Unlike schedule commands, Address is relative
+// to the API key, for example /groups/1/action.
+type RuleAction struct {
+	Address string          `json:"address"`
+	Method  string          `json:"method"`
+	Body    json.RawMessage `json:"body"`
+}
+
+func (c *V1Client) GetRule(ctx context.Context, ruleId string) (*Rule, error) {
+	var rule Rule
+	if err := c.Get(ctx, fmt.Sprintf("/rules/%s", ruleId), &rule); err != nil {
+		return nil, err
+	}
+
+	return &rule, nil
+}
+
+// CreateRule creates the rule and returns its ID
+func (c *V1Client) CreateRule(ctx context.Context, rule Rule) (string, error) {
+	return c.Create(ctx, "/rules", rule)
+}
+
+func (c *V1Client) UpdateRule(ctx context.Context, ruleId string, rule Rule) error {
+	return c.Update(ctx, fmt.Sprintf("/rules/%s", ruleId), rule)
+}
+
+func (c *V1Client) DeleteRule(ctx context.Context, ruleId string) error {
+	return c.Delete(ctx, fmt.Sprintf("/rules/%s", ruleId))
+}
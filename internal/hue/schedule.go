@@ -0,0 +1,56 @@
+package hue
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Statuses of a v1 schedule
+const (
+	ScheduleStatusEnabled  = "enabled"
+	ScheduleStatusDisabled = "disabled"
+)
+
+// Schedule is a v1 schedule, which sends a command to the bridge at a given local time.
+// The same type is used for reading and writing; read only fields are ignored by the bridge.
+type Schedule struct {
+	Name        *string          `json:"name,omitempty"`
+	Description *string          `json:"description,omitempty"`
+	Command     *ScheduleCommand `json:"command,omitempty"`
+	Localtime   *string          `json:"localtime,omitempty"`
+	Status      *string          `json:"status,omitempty"`
+	Autodelete  *bool            `json:"autodelete,omitempty"`
+	Created     *string          `json:"created,omitempty"`
+	Starttime   *string          `json:"starttime,omitempty"`
+}
+
+// ScheduleCommand is the request a schedule sends when it fires. Address is the full v1 address,
+// including the API key.
+type ScheduleCommand struct {
+	Address string          `json:"address"`
+	Method  string          `json:"method"`
+	Body    json.RawMessage `json:"body"`
+}
+
+func (c *V1Client) GetSchedule(ctx context.Context, scheduleId string) (*Schedule, error) {
+	var schedule Schedule
+	if err := c.Get(ctx, fmt.Sprintf("/schedules/%s", scheduleId), &schedule); err != nil {
+		return nil, err
+	}
+
+	return &schedule, nil
+}
+
+// CreateSchedule creates the schedule and returns its ID
+func (c *V1Client) CreateSchedule(ctx context.Context, schedule Schedule) (string, error) {
+	return c.Create(ctx, "/schedules", schedule)
+}
+
+func (c *V1Client) UpdateSchedule(ctx context.Context, scheduleId string, schedule Schedule) error {
+	return c.Update(ctx, fmt.Sprintf("/schedules/%s", scheduleId), schedule)
+}
+
+func (c *V1Client) DeleteSchedule(ctx context.Context, scheduleId string) error {
+	return c.Delete(ctx, fmt.Sprintf("/schedules/%s", scheduleId))
+}
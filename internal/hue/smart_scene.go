@@ -0,0 +1,106 @@
+package hue
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/openhue/openhue-go"
+	"github.com/ryanolee/terraform-provider-talk/internal/util"
+)
+
+// Kinds of timeslot start a smart scene supports
+const (
+	SmartSceneStartKindTime   = "time"
+	SmartSceneStartKindSunset = "sunset"
+)
+
+// Activation states of a smart scene and the recall actions that switch between them
+const (
+	SmartSceneStateActive      = "active"
+	SmartSceneStateInactive    = "inactive"
+	SmartSceneRecallActivate   = "activate"
+	SmartSceneRecallDeactivate = "deactivate"
+)
+
+// SmartScene is a smart_scene resource, which recalls a different scene depending on the weekday
+// and time of day. The same type is used for reading and writing; read only fields are ignored by the bridge.
+type SmartScene struct {
+	Id                 *string                     `json:"id,omitempty"`
+	Type               *string                     `json:"type,omitempty"`
+	Metadata           *SmartSceneMetadata         `json:"metadata,omitempty"`
+	Group              *openhue.ResourceIdentifier `json:"group,omitempty"`
+	WeekTimeslots      *[]SmartSceneWeekTimeslot   `json:"week_timeslots,omitempty"`
+	TransitionDuration *int                        `json:"transition_duration,omitempty"`
+	State              *string                     `json:"state,omitempty"`
+	ActiveTimeslot     *SmartSceneActiveTimeslot   `json:"active_timeslot,omitempty"`
+}
+
+type SmartSceneMetadata struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type SmartSceneWeekTimeslot struct {
+	Timeslots  []SmartSceneTimeslot `json:"timeslots"`
+	Recurrence []string             `json:"recurrence"`
+}
+
+type SmartSceneTimeslot struct {
+	StartTime SmartSceneStartTime        `json:"start_time"`
+	Target    openhue.ResourceIdentifier `json:"target"`
+}
+
+type SmartSceneStartTime struct {
+	Kind string          `json:"kind"`
+	Time *SmartSceneTime `json:"time,omitempty"`
+}
+
+type SmartSceneTime struct {
+	Hour   int `json:"hour"`
+	Minute int `json:"minute"`
+	Second int `json:"second"`
+}
+
+type SmartSceneActiveTimeslot struct {
+	TimeslotId int    `json:"timeslot_id"`
+	Weekday    string `json:"weekday"`
+}
+
+func (c *Client) GetSmartScene(ctx context.Context, smartSceneId string) (*SmartScene, error) {
+	return getSingleResource[SmartScene](ctx, c, "smart_scene", smartSceneId)
+}
+
+// CreateSmartScene creates the smart scene and returns its ID
+func (c *Client) CreateSmartScene(ctx context.Context, smartScene SmartScene) (string, error) {
+	smartScene.Type = util.StringPointer("smart_scene")
+
+	var created []openhue.ResourceIdentifier
+	if err := c.doResourceRequest(ctx, http.MethodPost, "smart_scene", smartScene, &created); err != nil {
+		return "", err
+	}
+
+	if len(created) == 0 || created[0].Rid == nil {
+		return "", fmt.Errorf("no data in response body")
+	}
+
+	return *created[0].Rid, nil
+}
+
+func (c *Client) UpdateSmartScene(ctx context.Context, smartSceneId string, smartScene SmartScene) error {
+	return c.doResourceRequest(ctx, http.MethodPut, fmt.Sprintf("smart_scene/%s", smartSceneId), smartScene, nil)
+}
+
+func (c *Client) DeleteSmartScene(ctx context.Context, smartSceneId string) error {
+	return c.doResourceRequest(ctx, http.MethodDelete, fmt.Sprintf("smart_scene/%s", smartSceneId), nil, nil)
+}
+
+// RecallSmartScene activates or deactivates the smart scene
+func (c *Client) RecallSmartScene(ctx context.Context, smartSceneId string, action string) error {
+	body := map[string]any{
+		"recall": map[string]string{
+			"action": action,
+		},
+	}
+
+	return c.doResourceRequest(ctx, http.MethodPut, fmt.Sprintf("smart_scene/%s", smartSceneId), body, nil)
+}
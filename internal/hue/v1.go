@@ -0,0 +1,147 @@
+package hue
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/ryanolee/terraform-provider-talk/internal/config"
+)
+
+// V1ErrorResourceNotAvailable is the v1 error type returned for resources that do not exist
+const V1ErrorResourceNotAvailable = 3
+
+// V1Client performs requests against the legacy CLIP v1 API (/api/<key>/...). It authenticates with
+// the same API key as the v2 client, which the v1 API takes as part of the path.
+type V1Client struct {
+	baseUrl    string
+	apiKey     string
+	httpClient *http.Client
+}
+
+// V1Error is an error reported by the v1 API. The v1 API reports errors in the response body,
+// usually alongside a 200 status code.
+type V1Error struct {
+	Type        int    `json:"type"`
+	Address     string `json:"address"`
+	Description string `json:"description"`
+}
+
+func (e *V1Error) Error() string {
+	return fmt.Sprintf("%s (type %d, address %s)", e.Description, e.Type, e.Address)
+}
+
+func newV1Client(authConfig *config.AuthConfig, httpClient *http.Client) *V1Client {
+	return &V1Client{
+		baseUrl:    fmt.Sprintf("https://%s/api/%s", authConfig.BridgeIp, authConfig.BridgeApiKey),
+		apiKey:     authConfig.BridgeApiKey,
+		httpClient: httpClient,
+	}
+}
+
+// Address returns the full v1 address of a resource path such as /groups/1/action, as used in the
+// commands of schedules and rules
+func (c *V1Client) Address(resourcePath string) string {
+	return fmt.Sprintf("/api/%s%s", c.apiKey, resourcePath)
+}
+
+// ResourcePath strips the API key prefix from a full v1 address, the inverse of Address
+func (c *V1Client) ResourcePath(address string) string {
+	return strings.TrimPrefix(address, fmt.Sprintf("/api/%s", c.apiKey))
+}
+
+// Get decodes the resource at the given path into out
+func (c *V1Client) Get(ctx context.Context, resourcePath string, out any) error {
+	return c.do(ctx, http.MethodGet, resourcePath, nil, out)
+}
+
+// Create posts a new resource to the given collection path and returns the ID the bridge gave it
+func (c *V1Client) Create(ctx context.Context, collectionPath string, body any) (string, error) {
+	var results []struct {
+		Success struct {
+			Id string `json:"id"`
+		} `json:"success"`
+	}
+
+	if err := c.do(ctx, http.MethodPost, collectionPath, body, &results); err != nil {
+		return "", err
+	}
+
+	if len(results) == 0 || results[0].Success.Id == "" {
+		return "", fmt.Errorf("no id in response body")
+	}
+
+	return results[0].Success.Id, nil
+}
+
+// Update writes the given attributes of the resource at the given path
+func (c *V1Client) Update(ctx context.Context, resourcePath string, body any) error {
+	return c.do(ctx, http.MethodPut, resourcePath, body, nil)
+}
+
+// Delete removes the resource at the given path
+func (c *V1Client) Delete(ctx context.Context, resourcePath string) error {
+	return c.do(ctx, http.MethodDelete, resourcePath, nil, nil)
+}
+
+func (c *V1Client) do(ctx context.Context, method string, resourcePath string, body any, out any) error {
+	var reqBody io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("failed to encode request body: %w", err)
+		}
+		reqBody = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseUrl+resourcePath, reqBody)
+	if err != nil {
+		return err
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	httpResp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if httpResp.StatusCode != http.StatusOK {
+		return &ApiError{StatusCode: httpResp.StatusCode, Status: httpResp.Status, Body: string(respBody)}
+	}
+
+	// Errors come back as a list of {"error": {...}} objects, which a successful GET never starts with
+	var results []struct {
+		Error *V1Error `json:"error"`
+	}
+
+	if json.Unmarshal(respBody, &results) == nil {
+		for _, result := range results {
+			if result.Error != nil {
+				return result.Error
+			}
+		}
+	}
+
+	if out == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("failed to decode response body: %w", err)
+	}
+
+	return nil
+}
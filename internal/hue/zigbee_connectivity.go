@@ -0,0 +1,69 @@
+package hue
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/openhue/openhue-go"
+)
+
+// Connectivity states reported by a zigbee_connectivity service
+const (
+	ZigbeeStatusConnected              = "connected"
+	ZigbeeStatusDisconnected           = "disconnected"
+	ZigbeeStatusConnectivityIssue      = "connectivity_issue"
+	ZigbeeStatusUnidirectionalIncoming = "unidirectional_incoming"
+)
+
+type ZigbeeConnectivityGet struct {
+	Id         *string                     `json:"id,omitempty"`
+	IdV1       *string                     `json:"id_v1,omitempty"`
+	Owner      *openhue.ResourceIdentifier `json:"owner,omitempty"`
+	Status     *string                     `json:"status,omitempty"`
+	MacAddress *string                     `json:"mac_address,omitempty"`
+	Type       *string                     `json:"type,omitempty"`
+}
+
+func (c *Client) GetZigbeeConnectivities(ctx context.Context) ([]ZigbeeConnectivityGet, error) {
+	var data []ZigbeeConnectivityGet
+	if err := c.doResourceRequest(ctx, http.MethodGet, "zigbee_connectivity", nil, &data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func (c *Client) GetZigbeeConnectivity(ctx context.Context, zigbeeConnectivityId string) (*ZigbeeConnectivityGet, error) {
+	return getSingleResource[ZigbeeConnectivityGet](ctx, c, "zigbee_connectivity", zigbeeConnectivityId)
+}
+
+// GetDeviceZigbeeConnectivity returns the zigbee_connectivity service of the given device,
+// or nil if the device does not have one
+func (c *Client) GetDeviceZigbeeConnectivity(ctx context.Context, deviceId string) (*ZigbeeConnectivityGet, error) {
+	deviceResp, err := c.GetDeviceWithResponse(ctx, deviceId)
+	if err != nil {
+		return nil, err
+	}
+
+	if deviceResp.HTTPResponse.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s, %s", deviceResp.HTTPResponse.Status, string(deviceResp.Body))
+	}
+
+	if deviceResp.JSON200 == nil || deviceResp.JSON200.Data == nil || len(*deviceResp.JSON200.Data) == 0 {
+		return nil, nil
+	}
+
+	device := (*deviceResp.JSON200.Data)[0]
+	if device.Services == nil {
+		return nil, nil
+	}
+
+	for _, service := range *device.Services {
+		if service.Rid != nil && service.Rtype != nil && *service.Rtype == openhue.ResourceIdentifierRtypeZigbeeConnectivity {
+			return c.GetZigbeeConnectivity(ctx, *service.Rid)
+		}
+	}
+
+	return nil, nil
+}
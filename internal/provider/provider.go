@@ -2,9 +2,7 @@ package provider
 
 import (
 	"context"
-	"crypto/tls"
 	"fmt"
-	"net/http"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -14,11 +12,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/openhue/openhue-go"
 
 	"github.com/ryanolee/terraform-provider-talk/internal/config"
 	"github.com/ryanolee/terraform-provider-talk/internal/datasources"
 	"github.com/ryanolee/terraform-provider-talk/internal/functions"
+	"github.com/ryanolee/terraform-provider-talk/internal/hue"
 	"github.com/ryanolee/terraform-provider-talk/internal/resources"
 )
 
@@ -85,21 +83,13 @@ func (p *OpenhueProvider) Configure(ctx context.Context, req provider.ConfigureR
 	}
 
 	// Create a new client with the provided bridge IP and API key
-	client, err := openhue.NewClientWithResponses(fmt.Sprintf("https://%s", authConfig.BridgeIp), openhue.WithRequestEditorFn(
-		func(ctx context.Context, req *http.Request) error {
-			req.Header.Set("hue-application-key", authConfig.BridgeApiKey)
-			return nil
-		},
-	))
+	client, err := hue.NewClient(authConfig)
 
 	if err != nil {
 		resp.Diagnostics.AddError("failed to create home", spew.Sprintf("failed to create home: %s", err.Error()))
 		return
 	}
 
-	// @todo - Find a way to isolate this to the clinet
-	http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
-
 	// Handoff "Client" to the provider
 	resp.DataSourceData = client
 	resp.ResourceData = client
@@ -110,12 +100,31 @@ func (p *OpenhueProvider) Resources(context.Context) []func() resource.Resource
 	return []func() resource.Resource{
 		resources.NewRoom,
 		resources.NewLight,
+		resources.NewMotionSensor,
+		resources.NewLightIdentify,
+		resources.NewSmartScene,
+		resources.NewSceneActivation,
+		resources.NewScene,
+		resources.NewSchedule,
+		resources.NewRule,
+		resources.NewClipSensor,
+		resources.NewBehaviorInstance,
+		resources.NewEntertainmentConfiguration,
+		resources.NewGeolocation,
+		resources.NewGeofenceClient,
 	}
 }
 
 func (p *OpenhueProvider) DataSources(context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		datasources.NewLightDataSource,
+		datasources.NewBridgeDataSource,
+		datasources.NewSensorsDataSource,
+		datasources.NewDeviceHealthDataSource,
+		datasources.NewScenesDataSource,
+		datasources.NewSceneDataSource,
+		datasources.NewBehaviorScriptsDataSource,
+		datasources.NewHomeDataSource,
 	}
 }
 
@@ -0,0 +1,387 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ryanolee/terraform-provider-talk/internal/hue"
+	"github.com/ryanolee/terraform-provider-talk/internal/util"
+)
+
+type (
+	BehaviorInstance struct {
+		client *hue.Client
+	}
+
+	behaviorInstanceResourceModel struct {
+		Id            types.String `tfsdk:"id"`
+		Name          types.String `tfsdk:"name"`
+		ScriptId      types.String `tfsdk:"script_id"`
+		ScriptName    types.String `tfsdk:"script_name"`
+		Enabled       types.Bool   `tfsdk:"enabled"`
+		Configuration types.String `tfsdk:"configuration"`
+		Status        types.String `tfsdk:"status"`
+	}
+)
+
+func NewBehaviorInstance() resource.Resource {
+	return &BehaviorInstance{}
+}
+
+func (r *BehaviorInstance) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_behavior_instance", req.ProviderTypeName)
+}
+
+func (r *BehaviorInstance) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Configure can be called multiple times (sometimes without provider data)
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*hue.Client)
+	if !ok {
+		resp.Diagnostics.AddError("expected hue.Client", fmt.Sprintf("Expected *hue.Client, got %T", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BehaviorInstance) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the behavior instance",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the automation as shown in the Hue app",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+			"script_id": schema.StringAttribute{
+				Description: "The ID of the behavior script the automation runs. Exactly one of `script_id` or `script_name` must be set. Changing the script replaces the automation.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("script_name")),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"script_name": schema.StringAttribute{
+				Description: "The name of the behavior script the automation runs, for example `Wake up` or `Timers`. See the `openhue_behavior_scripts` data source for the scripts of the bridge.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the automation is enabled. Defaults to `true`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"configuration": schema.StringAttribute{
+				Description: "The configuration of the automation as a JSON object, for example `jsonencode({ ... })`. It is checked against the configuration schema of the script while planning, including the types and allowed values of nested settings.",
+				Required:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The status the bridge reports for the automation, one of `initializing`, `running`, `disabled` or `errored`",
+				Computed:    true,
+			},
+		},
+		Description: "An automation in the Hue system, such as a wake up, go to sleep or timer, created from one of the behavior scripts of the bridge",
+	}
+}
+
+func (r *BehaviorInstance) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var configuration types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("configuration"), &configuration)...)
+
+	if resp.Diagnostics.HasError() || configuration.IsNull() || configuration.IsUnknown() {
+		return
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(configuration.ValueString()), &object); err != nil || object == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("configuration"), "invalid configuration", "configuration must be a JSON object")
+	}
+}
+
+func (r *BehaviorInstance) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the automation is being removed or the provider is not configured yet
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var model behaviorInstanceResourceModel
+
+	resp.Diagnostics.Append(
+		req.Config.Get(ctx, &model)...,
+	)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The script can only be resolved once it is known, for example not while its ID comes from another resource
+	if model.ScriptId.IsUnknown() || model.ScriptName.IsUnknown() {
+		return
+	}
+
+	scriptPath := path.Root("script_name")
+	if !model.ScriptId.IsNull() {
+		scriptPath = path.Root("script_id")
+	}
+
+	script, err := r.client.FindBehaviorScript(ctx, model.ScriptId.ValueString(), model.ScriptName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(scriptPath, "behavior script not found", err.Error())
+		return
+	}
+
+	scriptId := types.StringPointerValue(script.Id)
+	scriptName := types.StringNull()
+	if script.Metadata != nil {
+		scriptName = types.StringPointerValue(script.Metadata.Name)
+	}
+
+	var stateScriptId types.String
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("script_id"), &stateScriptId)...)
+	}
+
+	// An automation cannot switch to another script
+	if !stateScriptId.IsNull() && stateScriptId.ValueString() != scriptId.ValueString() {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("script_id"))
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("script_id"), scriptId)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("script_name"), scriptName)...)
+
+	if model.Configuration.IsNull() || model.Configuration.IsUnknown() {
+		return
+	}
+
+	// Invalid JSON is reported by ValidateConfig
+	var configuration map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(model.Configuration.ValueString()), &configuration); err != nil {
+		return
+	}
+
+	if err := script.ValidateConfiguration(configuration); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("configuration"), "invalid configuration", fmt.Sprintf("configuration does not match the schema of script %s: %s", scriptName.ValueString(), err.Error()))
+	}
+}
+
+func (r *BehaviorInstance) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to create behavior instance", "client is nil")
+		return
+	}
+
+	var model behaviorInstanceResourceModel
+
+	resp.Diagnostics.Append(
+		req.Plan.Get(ctx, &model)...,
+	)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Creating behavior instance %s", model.Name.String()))
+
+	// The script is still unknown if it could not be resolved while planning
+	if model.ScriptId.IsUnknown() {
+		script, err := r.client.FindBehaviorScript(ctx, "", model.ScriptName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("failed to find behavior script", fmt.Sprintf("failed to find behavior script: %s", err.Error()))
+			return
+		}
+
+		model.ScriptId = types.StringPointerValue(script.Id)
+	}
+
+	payload := behaviorInstanceModelToPayload(&model)
+	payload.ScriptId = model.ScriptId.ValueStringPointer()
+
+	id, err := r.client.CreateBehaviorInstance(ctx, payload)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create behavior instance", fmt.Sprintf("failed to create behavior instance: %s", err.Error()))
+		return
+	}
+
+	behaviorInstance, err := r.client.GetBehaviorInstance(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get behavior instance", fmt.Sprintf("failed to get behavior instance: %s", err.Error()))
+		return
+	}
+
+	model = r.mapBehaviorInstanceToModel(ctx, model, behaviorInstance)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *BehaviorInstance) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to read behavior instance", "client is nil")
+		return
+	}
+
+	var model behaviorInstanceResourceModel
+
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &model)...,
+	)
+
+	tflog.Info(ctx, fmt.Sprintf("Reading behavior instance %s", model.Id.String()))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	behaviorInstance, err := r.client.GetBehaviorInstance(ctx, model.Id.ValueString())
+	if hue.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get behavior instance", fmt.Sprintf("failed to get behavior instance: %s", err.Error()))
+		return
+	}
+
+	model = r.mapBehaviorInstanceToModel(ctx, model, behaviorInstance)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *BehaviorInstance) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to update behavior instance", "client is nil")
+		return
+	}
+
+	var model behaviorInstanceResourceModel
+
+	resp.Diagnostics.Append(
+		req.Plan.Get(ctx, &model)...,
+	)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updating behavior instance %s", model.Id.String()))
+
+	if err := r.client.UpdateBehaviorInstance(ctx, model.Id.ValueString(), behaviorInstanceModelToPayload(&model)); err != nil {
+		resp.Diagnostics.AddError("failed to update behavior instance", fmt.Sprintf("failed to update behavior instance: %s", err.Error()))
+		return
+	}
+
+	behaviorInstance, err := r.client.GetBehaviorInstance(ctx, model.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get behavior instance", fmt.Sprintf("failed to get behavior instance: %s", err.Error()))
+		return
+	}
+
+	model = r.mapBehaviorInstanceToModel(ctx, model, behaviorInstance)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *BehaviorInstance) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to delete behavior instance", "client is nil")
+		return
+	}
+
+	var model behaviorInstanceResourceModel
+
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &model)...,
+	)
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting behavior instance %s", model.Id.String()))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteBehaviorInstance(ctx, model.Id.ValueString()); err != nil && !hue.IsNotFound(err) {
+		resp.Diagnostics.AddError("failed to delete behavior instance", fmt.Sprintf("failed to delete behavior instance: %s", err.Error()))
+		return
+	}
+}
+
+func (r *BehaviorInstance) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// behaviorInstanceModelToPayload builds the attributes that can be updated. The script is only sent on create.
+func behaviorInstanceModelToPayload(model *behaviorInstanceResourceModel) hue.BehaviorInstance {
+	return hue.BehaviorInstance{
+		Enabled:       model.Enabled.ValueBoolPointer(),
+		Configuration: json.RawMessage(model.Configuration.ValueString()),
+		Metadata: &hue.BehaviorInstanceMetadata{
+			Name: model.Name.ValueStringPointer(),
+		},
+	}
+}
+
+func (r *BehaviorInstance) mapBehaviorInstanceToModel(ctx context.Context, behaviorInstanceModel behaviorInstanceResourceModel, behaviorInstance *hue.BehaviorInstance) behaviorInstanceResourceModel {
+	model := behaviorInstanceResourceModel{
+		Id:            types.StringPointerValue(behaviorInstance.Id),
+		Name:          types.StringNull(),
+		ScriptId:      types.StringPointerValue(behaviorInstance.ScriptId),
+		ScriptName:    behaviorInstanceModel.ScriptName,
+		Enabled:       types.BoolPointerValue(behaviorInstance.Enabled),
+		Configuration: types.StringValue(string(behaviorInstance.Configuration)),
+		Status:        types.StringPointerValue(behaviorInstance.Status),
+	}
+
+	if behaviorInstance.Metadata != nil {
+		model.Name = types.StringPointerValue(behaviorInstance.Metadata.Name)
+	}
+
+	// Keep the configuration as written as long as the bridge kept every configured key, since it adds
+	// defaults for the keys left out. A configured key the bridge reports differently is drift.
+	if util.JsonObjectSubset(behaviorInstanceModel.Configuration.ValueString(), string(behaviorInstance.Configuration)) {
+		model.Configuration = behaviorInstanceModel.Configuration
+	}
+
+	// Imported automations only know their script ID
+	if model.ScriptName.IsNull() || model.ScriptName.IsUnknown() {
+		model.ScriptName = types.StringNull()
+
+		script, err := r.client.FindBehaviorScript(ctx, model.ScriptId.ValueString(), "")
+		if err != nil {
+			tflog.Warn(ctx, fmt.Sprintf("Failed to find behavior script %s: %s", model.ScriptId.String(), err.Error()))
+		} else if script.Metadata != nil {
+			model.ScriptName = types.StringPointerValue(script.Metadata.Name)
+		}
+	}
+
+	return model
+}
@@ -0,0 +1,275 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ryanolee/terraform-provider-talk/internal/hue"
+)
+
+type (
+	ClipSensor struct {
+		client *hue.Client
+	}
+
+	clipSensorResourceModel struct {
+		Id      types.String `tfsdk:"id"`
+		Name    types.String `tfsdk:"name"`
+		Type    types.String `tfsdk:"type"`
+		Flag    types.Bool   `tfsdk:"flag"`
+		Status  types.Int64  `tfsdk:"status"`
+		Address types.String `tfsdk:"address"`
+	}
+)
+
+func NewClipSensor() resource.Resource {
+	return &ClipSensor{}
+}
+
+func (r *ClipSensor) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_clip_sensor", req.ProviderTypeName)
+}
+
+func (r *ClipSensor) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Configure can be called multiple times (sometimes without provider data)
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*hue.Client)
+	if !ok {
+		resp.Diagnostics.AddError("expected hue.Client", fmt.Sprintf("Expected *hue.Client, got %T", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ClipSensor) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The v1 ID of the sensor",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the sensor",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "The type of the sensor, either `CLIPGenericFlag` for a true or false flag or `CLIPGenericStatus` for a number",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(hue.ClipSensorTypeGenericFlag, hue.ClipSensorTypeGenericStatus),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"flag": schema.BoolAttribute{
+				Description: "The current flag of a `CLIPGenericFlag` sensor. The state is left to the systems that update the sensor.",
+				Computed:    true,
+			},
+			"status": schema.Int64Attribute{
+				Description: "The current status of a `CLIPGenericStatus` sensor. The state is left to the systems that update the sensor.",
+				Computed:    true,
+			},
+			"address": schema.StringAttribute{
+				Description: "The v1 address of the flag or status of the sensor, for example `/sensors/5/state/flag`. Use it as the address of rule conditions.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Description: "A virtual CLIP sensor on the legacy v1 API, holding a flag or status that other systems update and rules react to",
+	}
+}
+
+func (r *ClipSensor) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to create clip sensor", "client is nil")
+		return
+	}
+
+	var model clipSensorResourceModel
+
+	resp.Diagnostics.Append(
+		req.Plan.Get(ctx, &model)...,
+	)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Creating clip sensor %s", model.Name.String()))
+
+	id, err := r.client.V1.CreateClipSensor(ctx, model.Name.ValueString(), model.Type.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create clip sensor", fmt.Sprintf("failed to create clip sensor: %s", err.Error()))
+		return
+	}
+
+	model.Id = types.StringValue(id)
+
+	r.readClipSensor(ctx, &model, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ClipSensor) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to read clip sensor", "client is nil")
+		return
+	}
+
+	var model clipSensorResourceModel
+
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &model)...,
+	)
+
+	tflog.Info(ctx, fmt.Sprintf("Reading clip sensor %s", model.Id.String()))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sensor, err := r.client.V1.GetClipSensor(ctx, model.Id.ValueString())
+	if hue.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get clip sensor", fmt.Sprintf("failed to get clip sensor: %s", err.Error()))
+		return
+	}
+
+	model = mapClipSensorToModel(model.Id.ValueString(), sensor, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ClipSensor) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to update clip sensor", "client is nil")
+		return
+	}
+
+	var model clipSensorResourceModel
+
+	resp.Diagnostics.Append(
+		req.Plan.Get(ctx, &model)...,
+	)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updating clip sensor %s", model.Id.String()))
+
+	// The type requires replacement, which leaves the name as the only attribute to update
+	if err := r.client.V1.UpdateClipSensorName(ctx, model.Id.ValueString(), model.Name.ValueString()); err != nil {
+		resp.Diagnostics.AddError("failed to update clip sensor", fmt.Sprintf("failed to update clip sensor: %s", err.Error()))
+		return
+	}
+
+	r.readClipSensor(ctx, &model, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *ClipSensor) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to delete clip sensor", "client is nil")
+		return
+	}
+
+	var model clipSensorResourceModel
+
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &model)...,
+	)
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting clip sensor %s", model.Id.String()))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.V1.DeleteClipSensor(ctx, model.Id.ValueString()); err != nil && !hue.IsNotFound(err) {
+		resp.Diagnostics.AddError("failed to delete clip sensor", fmt.Sprintf("failed to delete clip sensor: %s", err.Error()))
+		return
+	}
+}
+
+func (r *ClipSensor) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// readClipSensor fills in the state of the sensor after it was written
+func (r *ClipSensor) readClipSensor(ctx context.Context, model *clipSensorResourceModel, diags *diag.Diagnostics) {
+	sensor, err := r.client.V1.GetClipSensor(ctx, model.Id.ValueString())
+	if err != nil {
+		diags.AddError("failed to get clip sensor", fmt.Sprintf("failed to get clip sensor: %s", err.Error()))
+		return
+	}
+
+	*model = mapClipSensorToModel(model.Id.ValueString(), sensor, diags)
+}
+
+func mapClipSensorToModel(id string, sensor *hue.ClipSensor, diags *diag.Diagnostics) clipSensorResourceModel {
+	model := clipSensorResourceModel{
+		Id:     types.StringValue(id),
+		Name:   types.StringPointerValue(sensor.Name),
+		Type:   types.StringPointerValue(sensor.Type),
+		Flag:   types.BoolNull(),
+		Status: types.Int64Null(),
+	}
+
+	sensorType := model.Type.ValueString()
+	if sensorType != hue.ClipSensorTypeGenericFlag && sensorType != hue.ClipSensorTypeGenericStatus {
+		diags.AddError("unsupported sensor type", fmt.Sprintf("sensor %s is a %s, only %s and %s sensors are supported", id, sensorType, hue.ClipSensorTypeGenericFlag, hue.ClipSensorTypeGenericStatus))
+		return model
+	}
+
+	model.Address = types.StringValue(fmt.Sprintf("/sensors/%s/state/%s", id, hue.ClipSensorStateAttribute(sensorType)))
+
+	if sensor.State == nil {
+		return model
+	}
+
+	if sensorType == hue.ClipSensorTypeGenericFlag {
+		model.Flag = types.BoolPointerValue(sensor.State.Flag)
+	} else if sensor.State.Status != nil {
+		model.Status = types.Int64Value(int64(*sensor.State.Status))
+	}
+
+	return model
+}
@@ -0,0 +1,523 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openhue/openhue-go"
+	"github.com/ryanolee/terraform-provider-talk/internal/hue"
+)
+
+// entertainmentPositionTolerance is how far a position read back from the bridge may be from the
+// configured one and still count as the same
+const entertainmentPositionTolerance = 0.001
+
+type (
+	EntertainmentConfiguration struct {
+		client *hue.Client
+	}
+
+	entertainmentConfigurationResourceModel struct {
+		Id                types.String                                     `tfsdk:"id"`
+		Name              types.String                                     `tfsdk:"name"`
+		ConfigurationType types.String                                     `tfsdk:"configuration_type"`
+		Lights            []entertainmentConfigurationResourceModelLight   `tfsdk:"lights"`
+		Channels          []entertainmentConfigurationResourceModelChannel `tfsdk:"channels"`
+		Status            types.String                                     `tfsdk:"status"`
+	}
+
+	entertainmentConfigurationResourceModelLight struct {
+		LightId   types.String                                      `tfsdk:"light_id"`
+		Positions []entertainmentConfigurationResourceModelPosition `tfsdk:"positions"`
+	}
+
+	entertainmentConfigurationResourceModelPosition struct {
+		X types.Float64 `tfsdk:"x"`
+		Y types.Float64 `tfsdk:"y"`
+		Z types.Float64 `tfsdk:"z"`
+	}
+
+	entertainmentConfigurationResourceModelChannel struct {
+		ChannelId types.Int64    `tfsdk:"channel_id"`
+		X         types.Float64  `tfsdk:"x"`
+		Y         types.Float64  `tfsdk:"y"`
+		Z         types.Float64  `tfsdk:"z"`
+		LightIds  []types.String `tfsdk:"light_ids"`
+	}
+)
+
+func NewEntertainmentConfiguration() resource.Resource {
+	return &EntertainmentConfiguration{}
+}
+
+func (r *EntertainmentConfiguration) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_entertainment_configuration", req.ProviderTypeName)
+}
+
+func (r *EntertainmentConfiguration) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Configure can be called multiple times (sometimes without provider data)
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*hue.Client)
+	if !ok {
+		resp.Diagnostics.AddError("expected hue.Client", fmt.Sprintf("Expected *hue.Client, got %T", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *EntertainmentConfiguration) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	axisValidators := []validator.Float64{
+		float64validator.Between(-1, 1),
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the entertainment configuration",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the entertainment area",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+			"configuration_type": schema.StringAttribute{
+				Description: "What the area is used for, one of `screen`, `monitor`, `music`, `3dspace` or `other`",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						hue.EntertainmentConfigurationTypeScreen,
+						hue.EntertainmentConfigurationTypeMonitor,
+						hue.EntertainmentConfigurationTypeMusic,
+						hue.EntertainmentConfigurationType3dSpace,
+						hue.EntertainmentConfigurationTypeOther,
+					),
+				},
+			},
+			"lights": schema.ListNestedAttribute{
+				Description: "The lights in the area and where they are placed",
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"light_id": schema.StringAttribute{
+							Description: "The ID of the light. The light must support entertainment streaming.",
+							Required:    true,
+						},
+						"positions": schema.ListNestedAttribute{
+							Description: "Where the light is placed, seen from the viewer. Lights with several segments, such as gradient strips, take one position per segment.",
+							Required:    true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"x": schema.Float64Attribute{
+										Description: "From left (-1) to right (1)",
+										Required:    true,
+										Validators:  axisValidators,
+									},
+									"y": schema.Float64Attribute{
+										Description: "From the front (-1) to the back (1)",
+										Required:    true,
+										Validators:  axisValidators,
+									},
+									"z": schema.Float64Attribute{
+										Description: "From the floor (-1) to the ceiling (1)",
+										Required:    true,
+										Validators:  axisValidators,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"channels": schema.ListNestedAttribute{
+				Description: "The channels the bridge derived from the lights, as a streaming client sees them",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"channel_id": schema.Int64Attribute{
+							Description: "The ID of the channel",
+							Computed:    true,
+						},
+						"x": schema.Float64Attribute{
+							Description: "The position of the channel from left to right",
+							Computed:    true,
+						},
+						"y": schema.Float64Attribute{
+							Description: "The position of the channel from front to back",
+							Computed:    true,
+						},
+						"z": schema.Float64Attribute{
+							Description: "The position of the channel from floor to ceiling",
+							Computed:    true,
+						},
+						"light_ids": schema.ListAttribute{
+							Description: "The IDs of the lights rendering the channel",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Whether the area is currently streaming, either `active` or `inactive`",
+				Computed:    true,
+			},
+		},
+		Description: "An entertainment area used by the Hue Sync app and sync boxes. Only the layout is managed; streaming is left to the apps.",
+	}
+}
+
+func (r *EntertainmentConfiguration) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to create entertainment configuration", "client is nil")
+		return
+	}
+
+	var model entertainmentConfigurationResourceModel
+
+	resp.Diagnostics.Append(
+		req.Plan.Get(ctx, &model)...,
+	)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Creating entertainment configuration %s", model.Name.String()))
+
+	services, err := r.client.GetEntertainmentServicesByLight(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get entertainment services", fmt.Sprintf("failed to get entertainment services: %s", err.Error()))
+		return
+	}
+
+	payload := entertainmentConfigurationModelToPayload(&model, services, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := r.client.CreateEntertainmentConfiguration(ctx, payload)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create entertainment configuration", fmt.Sprintf("failed to create entertainment configuration: %s", err.Error()))
+		return
+	}
+
+	model.Id = types.StringValue(id)
+
+	r.readEntertainmentConfiguration(ctx, &model, services, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *EntertainmentConfiguration) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to read entertainment configuration", "client is nil")
+		return
+	}
+
+	var model entertainmentConfigurationResourceModel
+
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &model)...,
+	)
+
+	tflog.Info(ctx, fmt.Sprintf("Reading entertainment configuration %s", model.Id.String()))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entertainmentConfiguration, err := r.client.GetEntertainmentConfiguration(ctx, model.Id.ValueString())
+	if hue.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get entertainment configuration", fmt.Sprintf("failed to get entertainment configuration: %s", err.Error()))
+		return
+	}
+
+	services, err := r.client.GetEntertainmentServicesByLight(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get entertainment services", fmt.Sprintf("failed to get entertainment services: %s", err.Error()))
+		return
+	}
+
+	model = mapEntertainmentConfigurationToModel(model, entertainmentConfiguration, services)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *EntertainmentConfiguration) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to update entertainment configuration", "client is nil")
+		return
+	}
+
+	var model entertainmentConfigurationResourceModel
+
+	resp.Diagnostics.Append(
+		req.Plan.Get(ctx, &model)...,
+	)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updating entertainment configuration %s", model.Id.String()))
+
+	services, err := r.client.GetEntertainmentServicesByLight(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get entertainment services", fmt.Sprintf("failed to get entertainment services: %s", err.Error()))
+		return
+	}
+
+	payload := entertainmentConfigurationModelToPayload(&model, services, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.UpdateEntertainmentConfiguration(ctx, model.Id.ValueString(), payload); err != nil {
+		resp.Diagnostics.AddError("failed to update entertainment configuration", fmt.Sprintf("failed to update entertainment configuration: %s", err.Error()))
+		return
+	}
+
+	r.readEntertainmentConfiguration(ctx, &model, services, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *EntertainmentConfiguration) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to delete entertainment configuration", "client is nil")
+		return
+	}
+
+	var model entertainmentConfigurationResourceModel
+
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &model)...,
+	)
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting entertainment configuration %s", model.Id.String()))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteEntertainmentConfiguration(ctx, model.Id.ValueString()); err != nil && !hue.IsNotFound(err) {
+		resp.Diagnostics.AddError("failed to delete entertainment configuration", fmt.Sprintf("failed to delete entertainment configuration: %s", err.Error()))
+		return
+	}
+}
+
+func (r *EntertainmentConfiguration) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// readEntertainmentConfiguration fills in the channels and status after the configuration was written
+func (r *EntertainmentConfiguration) readEntertainmentConfiguration(ctx context.Context, model *entertainmentConfigurationResourceModel, services map[string]string, diags *diag.Diagnostics) {
+	entertainmentConfiguration, err := r.client.GetEntertainmentConfiguration(ctx, model.Id.ValueString())
+	if err != nil {
+		diags.AddError("failed to get entertainment configuration", fmt.Sprintf("failed to get entertainment configuration: %s", err.Error()))
+		return
+	}
+
+	*model = mapEntertainmentConfigurationToModel(*model, entertainmentConfiguration, services)
+}
+
+// entertainmentConfigurationModelToPayload places the entertainment service of each configured light
+func entertainmentConfigurationModelToPayload(model *entertainmentConfigurationResourceModel, services map[string]string, diags *diag.Diagnostics) hue.EntertainmentConfiguration {
+	locations := &hue.EntertainmentConfigurationLocations{
+		ServiceLocations: []hue.EntertainmentServiceLocation{},
+	}
+
+	serviceType := openhue.ResourceIdentifierRtypeEntertainment
+	for i, light := range model.Lights {
+		serviceId, ok := services[light.LightId.ValueString()]
+		if !ok {
+			diags.AddAttributeError(path.Root("lights").AtListIndex(i).AtName("light_id"), "light does not support entertainment", fmt.Sprintf("light %s has no entertainment service", light.LightId.String()))
+			continue
+		}
+
+		location := hue.EntertainmentServiceLocation{
+			Service: openhue.ResourceIdentifier{
+				Rid:   &serviceId,
+				Rtype: &serviceType,
+			},
+		}
+
+		for _, position := range light.Positions {
+			location.Positions = append(location.Positions, hue.EntertainmentPosition{
+				X: position.X.ValueFloat64(),
+				Y: position.Y.ValueFloat64(),
+				Z: position.Z.ValueFloat64(),
+			})
+		}
+
+		locations.ServiceLocations = append(locations.ServiceLocations, location)
+	}
+
+	return hue.EntertainmentConfiguration{
+		Metadata: &hue.EntertainmentConfigurationMetadata{
+			Name: model.Name.ValueStringPointer(),
+		},
+		ConfigurationType: model.ConfigurationType.ValueStringPointer(),
+		Locations:         locations,
+	}
+}
+
+func mapEntertainmentConfigurationToModel(entertainmentConfigurationModel entertainmentConfigurationResourceModel, entertainmentConfiguration *hue.EntertainmentConfiguration, services map[string]string) entertainmentConfigurationResourceModel {
+	model := entertainmentConfigurationResourceModel{
+		Id:                types.StringPointerValue(entertainmentConfiguration.Id),
+		Name:              types.StringNull(),
+		ConfigurationType: types.StringPointerValue(entertainmentConfiguration.ConfigurationType),
+		Channels:          []entertainmentConfigurationResourceModelChannel{},
+		Status:            types.StringPointerValue(entertainmentConfiguration.Status),
+	}
+
+	if entertainmentConfiguration.Metadata != nil {
+		model.Name = types.StringPointerValue(entertainmentConfiguration.Metadata.Name)
+	}
+
+	lightsByService := make(map[string]string, len(services))
+	for lightId, serviceId := range services {
+		lightsByService[serviceId] = lightId
+	}
+
+	if entertainmentConfiguration.Locations != nil {
+		model.Lights = mapEntertainmentLightsToModel(entertainmentConfigurationModel.Lights, entertainmentConfiguration.Locations.ServiceLocations, lightsByService)
+	}
+
+	for _, channel := range entertainmentConfiguration.Channels {
+		channelModel := entertainmentConfigurationResourceModelChannel{
+			ChannelId: types.Int64Value(int64(channel.ChannelId)),
+			X:         types.Float64Value(channel.Position.X),
+			Y:         types.Float64Value(channel.Position.Y),
+			Z:         types.Float64Value(channel.Position.Z),
+			LightIds:  []types.String{},
+		}
+
+		for _, member := range channel.Members {
+			if member.Service.Rid == nil {
+				continue
+			}
+
+			if lightId, ok := lightsByService[*member.Service.Rid]; ok {
+				channelModel.LightIds = append(channelModel.LightIds, types.StringValue(lightId))
+			}
+		}
+
+		model.Channels = append(model.Channels, channelModel)
+	}
+
+	return model
+}
+
+// mapEntertainmentLightsToModel reads back the lights of the area in the order of the prior lights,
+// since the bridge does not keep the service locations in the order they were written
+func mapEntertainmentLightsToModel(priorLights []entertainmentConfigurationResourceModelLight, serviceLocations []hue.EntertainmentServiceLocation, lightsByService map[string]string) []entertainmentConfigurationResourceModelLight {
+	priorByLight := map[string]entertainmentConfigurationResourceModelLight{}
+	order := map[string]int{}
+	for i, light := range priorLights {
+		priorByLight[light.LightId.ValueString()] = light
+		order[light.LightId.ValueString()] = i
+	}
+
+	ordered := make([]entertainmentConfigurationResourceModelLight, len(priorLights))
+	found := make([]bool, len(priorLights))
+	extra := []entertainmentConfigurationResourceModelLight{}
+
+	for _, location := range serviceLocations {
+		if location.Service.Rid == nil {
+			continue
+		}
+
+		lightId, ok := lightsByService[*location.Service.Rid]
+		if !ok {
+			continue
+		}
+
+		lightModel := entertainmentConfigurationResourceModelLight{
+			LightId:   types.StringValue(lightId),
+			Positions: mapEntertainmentPositionsToModel(priorByLight[lightId].Positions, location.Positions),
+		}
+
+		if i, ok := order[lightId]; ok {
+			ordered[i] = lightModel
+			found[i] = true
+			continue
+		}
+
+		extra = append(extra, lightModel)
+	}
+
+	// Lights removed outside of Terraform are dropped so the plan puts them back
+	result := []entertainmentConfigurationResourceModelLight{}
+	for i, light := range ordered {
+		if found[i] {
+			result = append(result, light)
+		}
+	}
+
+	return append(result, extra...)
+}
+
+// mapEntertainmentPositionsToModel returns the positions read back from the bridge, keeping each prior
+// position the bridge only rounded
+func mapEntertainmentPositionsToModel(priorPositions []entertainmentConfigurationResourceModelPosition, positions []hue.EntertainmentPosition) []entertainmentConfigurationResourceModelPosition {
+	result := []entertainmentConfigurationResourceModelPosition{}
+
+	for i, position := range positions {
+		if i < len(priorPositions) &&
+			math.Abs(priorPositions[i].X.ValueFloat64()-position.X) < entertainmentPositionTolerance &&
+			math.Abs(priorPositions[i].Y.ValueFloat64()-position.Y) < entertainmentPositionTolerance &&
+			math.Abs(priorPositions[i].Z.ValueFloat64()-position.Z) < entertainmentPositionTolerance {
+			result = append(result, priorPositions[i])
+			continue
+		}
+
+		result = append(result, entertainmentConfigurationResourceModelPosition{
+			X: types.Float64Value(position.X),
+			Y: types.Float64Value(position.Y),
+			Z: types.Float64Value(position.Z),
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,204 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ryanolee/terraform-provider-talk/internal/hue"
+)
+
+type (
+	GeofenceClient struct {
+		client *hue.Client
+	}
+
+	geofenceClientResourceModel struct {
+		Id       types.String `tfsdk:"id"`
+		Name     types.String `tfsdk:"name"`
+		IsAtHome types.Bool   `tfsdk:"is_at_home"`
+	}
+)
+
+func NewGeofenceClient() resource.Resource {
+	return &GeofenceClient{}
+}
+
+func (r *GeofenceClient) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_geofence_client", req.ProviderTypeName)
+}
+
+func (r *GeofenceClient) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Configure can be called multiple times (sometimes without provider data)
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*hue.Client)
+	if !ok {
+		resp.Diagnostics.AddError("expected hue.Client", fmt.Sprintf("Expected *hue.Client, got %T", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GeofenceClient) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the geofence client",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the geofence client, usually the phone reporting its location",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+			"is_at_home": schema.BoolAttribute{
+				Description: "Whether the client is at home. The bridge does not report it back, so it is only written when it changes in the configuration.",
+				Optional:    true,
+			},
+		},
+		Description: "A geofence client, a phone or other device that tells the bridge whether it is at home for away and coming home automations",
+	}
+}
+
+func (r *GeofenceClient) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to create geofence client", "client is nil")
+		return
+	}
+
+	var model geofenceClientResourceModel
+
+	resp.Diagnostics.Append(
+		req.Plan.Get(ctx, &model)...,
+	)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Creating geofence client %s", model.Name.String()))
+
+	id, err := r.client.CreateGeofenceClient(ctx, hue.GeofenceClient{
+		Name:     model.Name.ValueStringPointer(),
+		IsAtHome: model.IsAtHome.ValueBoolPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create geofence client", fmt.Sprintf("failed to create geofence client: %s", err.Error()))
+		return
+	}
+
+	model.Id = types.StringValue(id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *GeofenceClient) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to read geofence client", "client is nil")
+		return
+	}
+
+	var model geofenceClientResourceModel
+
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &model)...,
+	)
+
+	tflog.Info(ctx, fmt.Sprintf("Reading geofence client %s", model.Id.String()))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	geofenceClient, err := r.client.GetGeofenceClient(ctx, model.Id.ValueString())
+	if hue.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get geofence client", fmt.Sprintf("failed to get geofence client: %s", err.Error()))
+		return
+	}
+
+	// is_at_home is write only, so the prior value is kept
+	model.Id = types.StringPointerValue(geofenceClient.Id)
+	model.Name = types.StringPointerValue(geofenceClient.Name)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *GeofenceClient) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to update geofence client", "client is nil")
+		return
+	}
+
+	var model geofenceClientResourceModel
+
+	resp.Diagnostics.Append(
+		req.Plan.Get(ctx, &model)...,
+	)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updating geofence client %s", model.Id.String()))
+
+	err := r.client.UpdateGeofenceClient(ctx, model.Id.ValueString(), hue.GeofenceClient{
+		Name:     model.Name.ValueStringPointer(),
+		IsAtHome: model.IsAtHome.ValueBoolPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update geofence client", fmt.Sprintf("failed to update geofence client: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *GeofenceClient) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to delete geofence client", "client is nil")
+		return
+	}
+
+	var model geofenceClientResourceModel
+
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &model)...,
+	)
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting geofence client %s", model.Id.String()))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteGeofenceClient(ctx, model.Id.ValueString()); err != nil && !hue.IsNotFound(err) {
+		resp.Diagnostics.AddError("failed to delete geofence client", fmt.Sprintf("failed to delete geofence client: %s", err.Error()))
+		return
+	}
+}
+
+func (r *GeofenceClient) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
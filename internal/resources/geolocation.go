@@ -0,0 +1,235 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ryanolee/terraform-provider-talk/internal/hue"
+)
+
+type (
+	Geolocation struct {
+		client *hue.Client
+	}
+
+	geolocationResourceModel struct {
+		Id           types.String  `tfsdk:"id"`
+		Latitude     types.Float64 `tfsdk:"latitude"`
+		Longitude    types.Float64 `tfsdk:"longitude"`
+		IsConfigured types.Bool    `tfsdk:"is_configured"`
+		SunsetTime   types.String  `tfsdk:"sunset_time"`
+		DayType      types.String  `tfsdk:"day_type"`
+	}
+)
+
+func NewGeolocation() resource.Resource {
+	return &Geolocation{}
+}
+
+func (r *Geolocation) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_geolocation", req.ProviderTypeName)
+}
+
+func (r *Geolocation) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Configure can be called multiple times (sometimes without provider data)
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*hue.Client)
+	if !ok {
+		resp.Diagnostics.AddError("expected hue.Client", fmt.Sprintf("Expected *hue.Client, got %T", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *Geolocation) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the geolocation of the bridge",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"latitude": schema.Float64Attribute{
+				Description: "The latitude of the home",
+				Required:    true,
+				Validators: []validator.Float64{
+					float64validator.Between(-90, 90),
+				},
+			},
+			"longitude": schema.Float64Attribute{
+				Description: "The longitude of the home",
+				Required:    true,
+				Validators: []validator.Float64{
+					float64validator.Between(-180, 180),
+				},
+			},
+			"is_configured": schema.BoolAttribute{
+				Description: "Whether the bridge has a location",
+				Computed:    true,
+			},
+			"sunset_time": schema.StringAttribute{
+				Description: "The time of sunset today, for example `20:31:00`",
+				Computed:    true,
+			},
+			"day_type": schema.StringAttribute{
+				Description: "The kind of day today, for example `normal_day` or `polar_night`",
+				Computed:    true,
+			},
+		},
+		Description: "The location of the bridge, which sunrise and sunset based automations are derived from. The bridge has exactly one, which is adopted on create and left in place on destroy. The bridge does not report the coordinates back, so they are only planned again when the bridge reports it has no location.",
+	}
+}
+
+func (r *Geolocation) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to create geolocation", "client is nil")
+		return
+	}
+
+	var model geolocationResourceModel
+
+	resp.Diagnostics.Append(
+		req.Plan.Get(ctx, &model)...,
+	)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "Adopting geolocation")
+
+	geolocation, err := r.client.GetGeolocation(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get geolocation", fmt.Sprintf("failed to get geolocation: %s", err.Error()))
+		return
+	}
+
+	model.Id = types.StringPointerValue(geolocation.Id)
+
+	r.applyGeolocation(ctx, &model, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *Geolocation) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to read geolocation", "client is nil")
+		return
+	}
+
+	var model geolocationResourceModel
+
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &model)...,
+	)
+
+	tflog.Info(ctx, fmt.Sprintf("Reading geolocation %s", model.Id.String()))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	geolocation, err := r.client.GetGeolocation(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get geolocation", fmt.Sprintf("failed to get geolocation: %s", err.Error()))
+		return
+	}
+
+	model = mapGeolocationToModel(model, geolocation)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *Geolocation) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to update geolocation", "client is nil")
+		return
+	}
+
+	var model geolocationResourceModel
+
+	resp.Diagnostics.Append(
+		req.Plan.Get(ctx, &model)...,
+	)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updating geolocation %s", model.Id.String()))
+
+	r.applyGeolocation(ctx, &model, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *Geolocation) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// This is a no-op because the bridge always has a geolocation, and clearing it would break sunrise and sunset automations
+	return
+}
+
+func (r *Geolocation) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// applyGeolocation writes the coordinates and reads back the sun times they result in
+func (r *Geolocation) applyGeolocation(ctx context.Context, model *geolocationResourceModel, diags *diag.Diagnostics) {
+	if err := r.client.UpdateGeolocation(ctx, model.Id.ValueString(), model.Latitude.ValueFloat64(), model.Longitude.ValueFloat64()); err != nil {
+		diags.AddError("failed to update geolocation", fmt.Sprintf("failed to update geolocation: %s", err.Error()))
+		return
+	}
+
+	geolocation, err := r.client.GetGeolocation(ctx)
+	if err != nil {
+		diags.AddError("failed to get geolocation", fmt.Sprintf("failed to get geolocation: %s", err.Error()))
+		return
+	}
+
+	*model = mapGeolocationToModel(*model, geolocation)
+}
+
+func mapGeolocationToModel(geolocationModel geolocationResourceModel, geolocation *hue.Geolocation) geolocationResourceModel {
+	model := geolocationResourceModel{
+		Id:           types.StringPointerValue(geolocation.Id),
+		Latitude:     geolocationModel.Latitude,
+		Longitude:    geolocationModel.Longitude,
+		IsConfigured: types.BoolPointerValue(geolocation.IsConfigured),
+		SunsetTime:   types.StringNull(),
+		DayType:      types.StringNull(),
+	}
+
+	// Coordinates cleared outside of Terraform, for example by a bridge reset, are planned again
+	if geolocation.IsConfigured != nil && !*geolocation.IsConfigured {
+		model.Latitude = types.Float64Null()
+		model.Longitude = types.Float64Null()
+	}
+
+	if geolocation.SunToday != nil {
+		model.SunsetTime = types.StringPointerValue(geolocation.SunToday.SunsetTime)
+		model.DayType = types.StringPointerValue(geolocation.SunToday.DayType)
+	}
+
+	return model
+}
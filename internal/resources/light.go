@@ -2,33 +2,57 @@ package resources
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"regexp"
+	"slices"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/openhue/openhue-go"
+	"github.com/ryanolee/terraform-provider-talk/internal/hue"
+	"github.com/ryanolee/terraform-provider-talk/internal/util"
 )
 
 type (
 	Light struct {
-		client *openhue.ClientWithResponses
+		client *hue.Client
 	}
 
 	lightResourceModel struct {
-		Name       types.String            `tfsdk:"name"`
-		Id         types.String            `tfsdk:"id"`
-		On         types.Bool              `tfsdk:"on"`
-		Brightness types.Float32           `tfsdk:"brightness"`
-		Color      lightResourceModelColor `tfsdk:"color"`
+		Name                types.String                   `tfsdk:"name"`
+		Id                  types.String                   `tfsdk:"id"`
+		Room                types.String                   `tfsdk:"room"`
+		Match               types.String                   `tfsdk:"match"`
+		On                  types.Bool                     `tfsdk:"on"`
+		Brightness          types.Float32                  `tfsdk:"brightness"`
+		Color               lightResourceModelColor        `tfsdk:"color"`
+		ColorTemperature    types.Int64                    `tfsdk:"color_temperature"`
+		UnreachableBehavior types.String                   `tfsdk:"unreachable_behavior"`
+		Reachable           types.Bool                     `tfsdk:"reachable"`
+		Effect              types.String                   `tfsdk:"effect"`
+		TimedEffect         *lightResourceModelTimedEffect `tfsdk:"timed_effect"`
+		Gradient            *lightResourceModelGradient    `tfsdk:"gradient"`
+		Powerup             *lightResourceModelPowerup     `tfsdk:"powerup"`
 	}
 
 	lightResourceModelColor struct {
@@ -36,8 +60,45 @@ type (
 		Y types.Float32 `tfsdk:"y"`
 		Z types.Float32 `tfsdk:"z"`
 	}
+
+	lightResourceModelTimedEffect struct {
+		Name     types.String `tfsdk:"name"`
+		Duration types.Int64  `tfsdk:"duration"`
+	}
+
+	lightResourceModelGradient struct {
+		Mode   types.String   `tfsdk:"mode"`
+		Points []types.String `tfsdk:"points"`
+	}
+
+	lightResourceModelPowerup struct {
+		Preset           types.String  `tfsdk:"preset"`
+		OnMode           types.String  `tfsdk:"on_mode"`
+		On               types.Bool    `tfsdk:"on"`
+		DimmingMode      types.String  `tfsdk:"dimming_mode"`
+		Brightness       types.Float32 `tfsdk:"brightness"`
+		ColorMode        types.String  `tfsdk:"color_mode"`
+		ColorTemperature types.Int64   `tfsdk:"color_temperature"`
+		Color            types.String  `tfsdk:"color"`
+	}
 )
 
+// What to do when writing to a light the bridge cannot reach
+const (
+	unreachableBehaviorError       = "error"
+	unreachableBehaviorWarnAndSkip = "warn_and_skip"
+	unreachableBehaviorApplyAnyway = "apply_anyway"
+)
+
+// Timed effects supported by the Hue API. The generated client does not define these.
+const (
+	timedEffectSunrise  = "sunrise"
+	timedEffectSunset   = "sunset"
+	timedEffectNoEffect = "no_effect"
+)
+
+var hexColorRegex = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
 func NewLight() resource.Resource {
 	return &Light{}
 }
@@ -52,9 +113,9 @@ func (r *Light) Configure(ctx context.Context, req resource.ConfigureRequest, re
 		return
 	}
 
-	client, ok := req.ProviderData.(*openhue.ClientWithResponses)
+	client, ok := req.ProviderData.(*hue.Client)
 	if !ok {
-		resp.Diagnostics.AddError("expected openhue.ClientWithResponses", fmt.Sprintf("Expected *openhue.Client, got %T", req.ProviderData))
+		resp.Diagnostics.AddError("expected hue.Client", fmt.Sprintf("Expected *hue.Client, got %T", req.ProviderData))
 		return
 	}
 
@@ -65,15 +126,30 @@ func (r *Light) Schema(ctx context.Context, req resource.SchemaRequest, resp *re
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
+				Optional:    true,
 				Computed:    true,
-				Description: "The ID of the light",
+				Description: "The ID of the light. Either set to pick the light directly, or resolved from `name` and `room` when planning; the light is replaced if they come to refer to a different light.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"name": schema.StringAttribute{
-				Description: "The name of the light. This MUST match the name of the light in the Hue system exactly in order for the provider to find the light.",
-				Required:    true,
+				Description: "The name of the light, compared according to `match`. At least one of `name` or `id` must be set, and together with `room` they must select exactly one light.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"room": schema.StringAttribute{
+				Description: "The name of the room the light is in, compared according to `match`. Use this to pick between lights with the same name.",
+				Optional:    true,
+			},
+			"match": schema.StringAttribute{
+				Description: "How `name` and `room` are compared to the Hue system. One of `exact`, `case_insensitive` or `regex`. Defaults to `exact`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(hue.LightMatchExact),
+				Validators: []validator.String{
+					stringvalidator.OneOf(hue.LightMatchExact, hue.LightMatchCaseInsensitive, hue.LightMatchRegex),
+				},
 			},
 			"on": schema.BoolAttribute{
 				Description: "Whether the light is on or off",
@@ -82,8 +158,11 @@ func (r *Light) Schema(ctx context.Context, req resource.SchemaRequest, resp *re
 				Default:     booldefault.StaticBool(false),
 			},
 			"brightness": schema.Float32Attribute{
-				Description: "The brightness of the light",
+				Description: "The brightness of the light in percent. Values below the light's minimum dim level are sent at that level, with a warning when planning.",
 				Optional:    true,
+				Validators: []validator.Float32{
+					float32validator.Between(0, 100),
+				},
 			},
 			"color": schema.ObjectAttribute{
 				Description: "The color of the light",
@@ -109,14 +188,198 @@ func (r *Light) Schema(ctx context.Context, req resource.SchemaRequest, resp *re
 					),
 				),
 			},
+			"color_temperature": schema.Int64Attribute{
+				Description: "The color temperature of the light in mirek. Takes precedence over `color` when set.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.Between(153, 500),
+				},
+			},
+			"unreachable_behavior": schema.StringAttribute{
+				Description: "What to do when the bridge reports the light as unreachable (for example when it is turned off at the wall). " +
+					"`error` fails the apply, `warn_and_skip` leaves the light untouched and raises a warning and `apply_anyway` sends the update regardless. Defaults to `warn_and_skip`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(unreachableBehaviorWarnAndSkip),
+				Validators: []validator.String{
+					stringvalidator.OneOf(unreachableBehaviorError, unreachableBehaviorWarnAndSkip, unreachableBehaviorApplyAnyway),
+				},
+			},
+			"effect": schema.StringAttribute{
+				Description: "The effect to show on the light, one of `candle`, `fire`, `prism`, `sparkle`, `opal`, `glisten` or `no_effect`. Must be supported by the light.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(openhue.SupportedEffectsNoEffect),
+						string(openhue.SupportedEffectsCandle),
+						string(openhue.SupportedEffectsFire),
+						string(openhue.SupportedEffectsPrism),
+						string(openhue.SupportedEffectsSparkle),
+						string(openhue.SupportedEffectsOpal),
+						string(openhue.SupportedEffectsGlisten),
+					),
+				},
+			},
+			"timed_effect": schema.SingleNestedAttribute{
+				Description: "A timed effect to play on the light. Must be supported by the light.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Description: "The timed effect to play, one of `sunrise`, `sunset` or `no_effect`",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(timedEffectSunrise, timedEffectSunset, timedEffectNoEffect),
+						},
+					},
+					"duration": schema.Int64Attribute{
+						Description: "How long the timed effect should take in milliseconds",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+						},
+					},
+				},
+			},
+			"gradient": schema.SingleNestedAttribute{
+				Description: "The gradient to show on gradient capable lights such as Gradient Lightstrips and Play gradient tubes",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"mode": schema.StringAttribute{
+						Description: "How the gradient points are spread over the light, one of `interpolated_palette`, `interpolated_palette_mirrored` or `random_pixelated`. Must be supported by the light.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								string(openhue.InterpolatedPalette),
+								string(openhue.InterpolatedPaletteMirrored),
+								string(openhue.RandomPixelated),
+							),
+						},
+					},
+					"points": schema.ListAttribute{
+						Description: "The hex colors (for example `#ff0000`) of the gradient points. Lights support at most `points_capable` points, usually five.",
+						Required:    true,
+						ElementType: types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeBetween(2, 5),
+							listvalidator.ValueStringsAre(
+								stringvalidator.RegexMatches(hexColorRegex, "must be a hex color such as #ff0000"),
+							),
+						},
+					},
+				},
+			},
+			"powerup": schema.SingleNestedAttribute{
+				Description: "What the light does when it regains power, for example after a power cut. Removing this block leaves the light's current power-on behavior in place.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"preset": schema.StringAttribute{
+						Description: "The power-on preset. `safety` powers on in bright white, `powerfail` restores the state from before the power was lost, `last_on_state` restores the last state the light was on in and `custom` uses the other settings in this block.",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								string(openhue.PowerupPresetSafety),
+								string(openhue.PowerupPresetPowerfail),
+								string(openhue.PowerupPresetLastOnState),
+								string(openhue.PowerupPresetCustom),
+							),
+						},
+					},
+					"on_mode": schema.StringAttribute{
+						Description: "Custom presets only. Whether the light powers `on` (to the value of `on`), `toggle`s or restores its `previous` on state",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								string(openhue.PowerupOnModeOn),
+								string(openhue.PowerupOnModeToggle),
+								string(openhue.PowerupOnModePrevious),
+							),
+						},
+					},
+					"on": schema.BoolAttribute{
+						Description: "Custom presets only. Whether the light is on after powering up when `on_mode` is `on`. Defaults to true.",
+						Optional:    true,
+					},
+					"dimming_mode": schema.StringAttribute{
+						Description: "Custom presets only. Whether the light powers up at `brightness` (`dimming`) or its `previous` brightness",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								string(openhue.PowerupDimmingModeDimming),
+								string(openhue.PowerupDimmingModePrevious),
+							),
+						},
+					},
+					"brightness": schema.Float32Attribute{
+						Description: "Custom presets only. The brightness to power up at when `dimming_mode` is `dimming`",
+						Optional:    true,
+						Validators: []validator.Float32{
+							float32validator.Between(0, 100),
+						},
+					},
+					"color_mode": schema.StringAttribute{
+						Description: "Custom presets only. Whether the light powers up at `color_temperature`, at `color` or in its `previous` color",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								string(openhue.PowerupDimmingColorModeColorTemperature),
+								string(openhue.PowerupDimmingColorModeColor),
+								string(openhue.PowerupDimmingColorModePrevious),
+							),
+						},
+					},
+					"color_temperature": schema.Int64Attribute{
+						Description: "Custom presets only. The color temperature in mirek to power up at when `color_mode` is `color_temperature`",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.Between(153, 500),
+						},
+					},
+					"color": schema.StringAttribute{
+						Description: "Custom presets only. The hex color (for example `#ff0000`) to power up at when `color_mode` is `color`",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(hexColorRegex, "must be a hex color such as #ff0000"),
+						},
+					},
+				},
+			},
+			"reachable": schema.BoolAttribute{
+				Description: "Whether the bridge can currently reach the light over zigbee",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
 		},
 		Description: "A light in the Hue system",
 	}
 }
 
-func (r *Light) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	if r.client == nil {
-		resp.Diagnostics.AddError("failed to create light", "client is nil")
+func (r *Light) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var powerup *lightResourceModelPowerup
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("powerup"), &powerup)...)
+
+	selector, known := lightSelectorFromConfig(ctx, req.Config, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if known {
+		if err := selector.Validate(); err != nil {
+			resp.Diagnostics.AddError("invalid light selector", err.Error())
+		}
+	}
+
+	if powerup != nil {
+		validateLightPowerup(powerup, &resp.Diagnostics)
+	}
+}
+
+func (r *Light) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the light is being removed or the provider is not configured yet
+	if req.Plan.Raw.IsNull() || r.client == nil {
 		return
 	}
 
@@ -130,41 +393,104 @@ func (r *Light) Create(ctx context.Context, req resource.CreateRequest, resp *re
 		return
 	}
 
-	parsedApiResp, err := r.client.GetLightsWithResponse(ctx)
+	// The light can only be resolved once the selector is known, for example not while the name comes from another resource
+	selector, known := lightSelectorFromConfig(ctx, req.Config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() || !known {
+		return
+	}
 
+	light, err := r.client.FindLight(ctx, selector)
 	if err != nil {
-		resp.Diagnostics.AddError("failed to find light", fmt.Sprintf("failed to create light: %s", err.Error()))
+		var notFoundErr *hue.LightNotFoundError
+		var ambiguousErr *hue.AmbiguousLightError
+
+		switch {
+		case errors.As(err, &notFoundErr):
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "light not found", err.Error())
+		case errors.As(err, &ambiguousErr):
+			resp.Diagnostics.AddAttributeError(path.Root("name"), "ambiguous light", err.Error())
+		default:
+			tflog.Debug(ctx, fmt.Sprintf("Skipping light resolution: %s", err.Error()))
+		}
+
 		return
 	}
 
-	if parsedApiResp.HTTPResponse.StatusCode != http.StatusOK {
-		resp.Diagnostics.AddError("failed to find light", fmt.Sprintf("failed to create light: %s, %s", parsedApiResp.HTTPResponse.Status, string(parsedApiResp.Body)))
+	var stateId types.String
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &stateId)...)
+	}
+
+	// A name that now refers to a different light means a different physical light is being managed
+	if !stateId.IsNull() && stateId.ValueString() != *light.Id {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("id"))
+	}
+
+	model.Id = types.StringPointerValue(light.Id)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), model.Id)...)
+
+	if selector.Name == "" {
+		model.Name = types.StringPointerValue(light.Metadata.Name)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("name"), model.Name)...)
+	}
+
+	validateLightEffects(&model, light, &resp.Diagnostics)
+	validateLightColorTemperature(&model, light, &resp.Diagnostics)
+	validateLightGradient(&model, light, &resp.Diagnostics)
+
+	if brightness, raised := planLightBrightness(&model, light); raised {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("brightness"),
+			"brightness below minimum dim level",
+			fmt.Sprintf("light %s cannot be dimmed below %g%%, brightness %g will be sent as %g", model.Name.ValueString(), brightness, model.Brightness.ValueFloat32(), brightness),
+		)
+	}
+}
+
+func (r *Light) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to create light", "client is nil")
 		return
 	}
 
-	getLightResponse := *parsedApiResp.JSON200.Data
+	var model lightResourceModel
 
-	// Search for the light in the response
-	var targetLight *openhue.LightGet
-	for _, light := range getLightResponse {
-		if *light.Metadata.Name == model.Name.ValueString() {
-			targetLight = &light
-			break
-		}
+	resp.Diagnostics.Append(
+		req.Plan.Get(ctx, &model)...,
+	)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if targetLight == nil {
-		lampNames := make([]string, len(getLightResponse))
-		for i, light := range getLightResponse {
-			lampNames[i] = *light.Metadata.Name
-		}
+	targetLight, err := r.findPlannedLight(ctx, &model)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to find light", fmt.Sprintf("failed to create light: %s", err.Error()))
+		return
+	}
 
-		resp.Diagnostics.AddError("failed to find light", fmt.Sprintf("failed to create light: light %s not found. Available lamps %s", model.Name.String(), strings.Join(lampNames, ", ")))
+	if targetLight == nil {
+		resp.Diagnostics.AddError("failed to find light", fmt.Sprintf("failed to create light: light %s could not be resolved", model.Name.String()))
 		return
 	}
 
-	// Set the ID of the light
+	// Set the ID of the light, and its name if it was picked by ID
 	model.Id = types.StringPointerValue(targetLight.Id)
+	if model.Name.IsUnknown() {
+		model.Name = types.StringPointerValue(targetLight.Metadata.Name)
+	}
+
+	reachable, err := r.isLightReachable(ctx, targetLight)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to check light connectivity", fmt.Sprintf("failed to check light connectivity: %s", err.Error()))
+		return
+	}
+
+	model.Reachable = types.BoolValue(reachable)
+	if !reachable && !r.handleUnreachableLight(ctx, &model, &resp.Diagnostics) {
+		resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+		return
+	}
 
 	// Update light to reflect new state
 	tflog.Info(ctx, fmt.Sprintf("Updating light %s", model.Id.String()))
@@ -175,6 +501,11 @@ func (r *Light) Create(ctx context.Context, req resource.CreateRequest, resp *re
 		return
 	}
 
+	// The bridge cannot dim below the minimum dim level, the configured brightness is kept in state
+	if brightness, raised := planLightBrightness(&model, targetLight); raised {
+		lightPut.Dimming.Brightness = &brightness
+	}
+
 	updateResp, err := r.client.UpdateLightWithResponse(ctx, model.Id.ValueString(), *lightPut)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to update light", fmt.Sprintf("failed to update light: %s", err.Error()))
@@ -187,7 +518,7 @@ func (r *Light) Create(ctx context.Context, req resource.CreateRequest, resp *re
 	}
 
 	if updateResp.JSON200 == nil {
-		resp.Diagnostics.AddError("failed to update light", fmt.Sprintf("failed to update light: no parsed response body.", string(updateResp.Body)))
+		resp.Diagnostics.AddError("failed to update light", fmt.Sprintf("failed to update light: no parsed response body. %s", string(updateResp.Body)))
 		return
 	}
 
@@ -198,6 +529,13 @@ func (r *Light) Create(ctx context.Context, req resource.CreateRequest, resp *re
 		return
 	}
 
+	if model.Powerup != nil {
+		if err := r.client.UpdateLightPowerup(ctx, model.Id.ValueString(), lightPowerupModelToPayload(model.Powerup)); err != nil {
+			resp.Diagnostics.AddError("failed to update light powerup", fmt.Sprintf("failed to update light powerup: %s", err.Error()))
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
 
@@ -243,6 +581,23 @@ func (r *Light) Read(ctx context.Context, req resource.ReadRequest, resp *resour
 
 	model = mapLightStateToModel(model, &responseData[0])
 
+	if model.Powerup != nil {
+		powerup, err := r.client.GetLightPowerup(ctx, model.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("failed to get light powerup", fmt.Sprintf("failed to get light powerup: %s", err.Error()))
+			return
+		}
+
+		model.Powerup = mapLightPowerupToModel(model.Powerup, powerup)
+	}
+
+	reachable, err := r.isLightReachable(ctx, &responseData[0])
+	if err != nil {
+		resp.Diagnostics.AddError("failed to check light connectivity", fmt.Sprintf("failed to check light connectivity: %s", err.Error()))
+		return
+	}
+	model.Reachable = types.BoolValue(reachable)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
 
@@ -262,12 +617,68 @@ func (r *Light) Update(ctx context.Context, req resource.UpdateRequest, resp *re
 		return
 	}
 
+	light, err := r.findPlannedLight(ctx, &model)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get light", fmt.Sprintf("failed to get light: %s", err.Error()))
+		return
+	}
+
+	if light == nil {
+		resp.Diagnostics.AddError("failed to get light", fmt.Sprintf("failed to get light: light %s could not be resolved", model.Name.String()))
+		return
+	}
+
+	model.Id = types.StringPointerValue(light.Id)
+	if model.Name.IsUnknown() {
+		model.Name = types.StringPointerValue(light.Metadata.Name)
+	}
+
+	reachable, err := r.isLightReachable(ctx, light)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to check light connectivity", fmt.Sprintf("failed to check light connectivity: %s", err.Error()))
+		return
+	}
+
+	model.Reachable = types.BoolValue(reachable)
+	if !reachable && !r.handleUnreachableLight(ctx, &model, &resp.Diagnostics) {
+		// The next refresh reads the light's real state, so the skipped changes are planned again
+		resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+		return
+	}
+
 	lightPut, err := lightModelToPayload(&model)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to update light", fmt.Sprintf("failed to update light: %s", err.Error()))
 		return
 	}
 
+	// The bridge cannot dim below the minimum dim level, the configured brightness is kept in state
+	if brightness, raised := planLightBrightness(&model, light); raised {
+		lightPut.Dimming.Brightness = &brightness
+	}
+
+	// Stop any effect or timed effect that is no longer configured
+	var priorModel lightResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &priorModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if model.Effect.IsNull() && !priorModel.Effect.IsNull() {
+		noEffect := openhue.SupportedEffectsNoEffect
+		lightPut.Effects = &openhue.Effects{Effect: &noEffect}
+	}
+
+	if model.TimedEffect == nil && priorModel.TimedEffect != nil {
+		noTimedEffect := openhue.SupportedTimedEffects(timedEffectNoEffect)
+		lightPut.TimedEffects = &struct {
+			Duration *int                           "json:\"duration,omitempty\""
+			Effect   *openhue.SupportedTimedEffects "json:\"effect,omitempty\""
+		}{
+			Effect: &noTimedEffect,
+		}
+	}
+
 	apiResp, err := r.client.UpdateLightWithResponse(ctx, model.Id.ValueString(), *lightPut)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to update light", fmt.Sprintf("failed to update light: %s", err.Error()))
@@ -279,6 +690,13 @@ func (r *Light) Update(ctx context.Context, req resource.UpdateRequest, resp *re
 		return
 	}
 
+	if model.Powerup != nil {
+		if err := r.client.UpdateLightPowerup(ctx, model.Id.ValueString(), lightPowerupModelToPayload(model.Powerup)); err != nil {
+			resp.Diagnostics.AddError("failed to update light powerup", fmt.Sprintf("failed to update light powerup: %s", err.Error()))
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
 
@@ -287,8 +705,287 @@ func (r *Light) Delete(ctx context.Context, req resource.DeleteRequest, resp *re
 	return
 }
 
+// findPlannedLight returns the light a plan refers to, or nil if it cannot be known yet
+func (r *Light) findPlannedLight(ctx context.Context, model *lightResourceModel) (*openhue.LightGet, error) {
+	if !model.Id.IsUnknown() && !model.Id.IsNull() {
+		return r.getLight(ctx, model.Id.ValueString())
+	}
+
+	if model.Name.IsUnknown() || model.Room.IsUnknown() {
+		return nil, nil
+	}
+
+	return r.client.FindLight(ctx, lightSelectorFromModel(model))
+}
+
+// lightSelectorFromConfig builds the selector for the configured light. The returned bool is false
+// while any part of the selector is unknown, in which case the selector cannot be used yet.
+func lightSelectorFromConfig(ctx context.Context, config tfsdk.Config, diags *diag.Diagnostics) (hue.LightSelector, bool) {
+	var model lightResourceModel
+
+	diags.Append(config.GetAttribute(ctx, path.Root("id"), &model.Id)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("name"), &model.Name)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("room"), &model.Room)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("match"), &model.Match)...)
+
+	if model.Id.IsUnknown() || model.Name.IsUnknown() || model.Room.IsUnknown() || model.Match.IsUnknown() {
+		return hue.LightSelector{}, false
+	}
+
+	return lightSelectorFromModel(&model), true
+}
+
+func lightSelectorFromModel(model *lightResourceModel) hue.LightSelector {
+	selector := hue.LightSelector{
+		Id:    model.Id.ValueString(),
+		Name:  model.Name.ValueString(),
+		Room:  model.Room.ValueString(),
+		Match: model.Match.ValueString(),
+	}
+
+	if selector.Match == "" {
+		selector.Match = hue.LightMatchExact
+	}
+
+	return selector
+}
+
+// getLight fetches the current state of a light from the bridge
+func (r *Light) getLight(ctx context.Context, lightId string) (*openhue.LightGet, error) {
+	apiResp, err := r.client.GetLightWithResponse(ctx, lightId)
+	if err != nil {
+		return nil, err
+	}
+
+	if apiResp.HTTPResponse.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s, %s", apiResp.HTTPResponse.Status, string(apiResp.Body))
+	}
+
+	if apiResp.JSON200 == nil || apiResp.JSON200.Data == nil || len(*apiResp.JSON200.Data) == 0 {
+		return nil, fmt.Errorf("no data in response body")
+	}
+
+	return &(*apiResp.JSON200.Data)[0], nil
+}
+
+// isLightReachable checks the zigbee connectivity of the device owning the light.
+// Lights without a zigbee connectivity service are always considered reachable.
+func (r *Light) isLightReachable(ctx context.Context, light *openhue.LightGet) (bool, error) {
+	if light.Owner == nil || light.Owner.Rid == nil {
+		return true, nil
+	}
+
+	connectivity, err := r.client.GetDeviceZigbeeConnectivity(ctx, *light.Owner.Rid)
+	if err != nil {
+		return false, err
+	}
+
+	if connectivity == nil || connectivity.Status == nil {
+		return true, nil
+	}
+
+	return *connectivity.Status == hue.ZigbeeStatusConnected, nil
+}
+
+// handleUnreachableLight applies the configured unreachable behavior, returning whether the update
+// should still be sent to the light
+func (r *Light) handleUnreachableLight(ctx context.Context, model *lightResourceModel, diags *diag.Diagnostics) bool {
+	message := fmt.Sprintf("light %s (%s) is not reachable by the bridge, it may be switched off at the wall", model.Name.ValueString(), model.Id.ValueString())
+
+	switch model.UnreachableBehavior.ValueString() {
+	case unreachableBehaviorError:
+		diags.AddError("light unreachable", fmt.Sprintf("%s. Set unreachable_behavior to warn_and_skip or apply_anyway to ignore this.", message))
+		return false
+	case unreachableBehaviorWarnAndSkip:
+		diags.AddWarning("light unreachable", fmt.Sprintf("%s. Skipping update, it will be retried on the next apply.", message))
+		return false
+	default:
+		tflog.Warn(ctx, fmt.Sprintf("%s. Sending update anyway.", message))
+		return true
+	}
+}
+
+// validateLightEffects checks the planned effects against the ones the light reports it supports
+func validateLightEffects(model *lightResourceModel, light *openhue.LightGet, diags *diag.Diagnostics) {
+	if !model.Effect.IsNull() && !model.Effect.IsUnknown() {
+		supported := []string{}
+		if light.Effects != nil && light.Effects.EffectValues != nil {
+			for _, effect := range *light.Effects.EffectValues {
+				supported = append(supported, string(effect))
+			}
+		}
+
+		if !slices.Contains(supported, model.Effect.ValueString()) {
+			diags.AddAttributeError(
+				path.Root("effect"),
+				"unsupported effect",
+				fmt.Sprintf("light %s does not support the effect %s. Supported effects: %s", model.Name.ValueString(), model.Effect.ValueString(), strings.Join(supported, ", ")),
+			)
+		}
+	}
+
+	if model.TimedEffect != nil && !model.TimedEffect.Name.IsNull() && !model.TimedEffect.Name.IsUnknown() {
+		supported := []string{}
+		if light.TimedEffects != nil && light.TimedEffects.EffectValues != nil {
+			for _, effect := range *light.TimedEffects.EffectValues {
+				supported = append(supported, string(effect))
+			}
+		}
+
+		if !slices.Contains(supported, model.TimedEffect.Name.ValueString()) {
+			diags.AddAttributeError(
+				path.Root("timed_effect").AtName("name"),
+				"unsupported timed effect",
+				fmt.Sprintf("light %s does not support the timed effect %s. Supported timed effects: %s", model.Name.ValueString(), model.TimedEffect.Name.ValueString(), strings.Join(supported, ", ")),
+			)
+		}
+	}
+}
+
+// validateLightColorTemperature checks the planned color temperature against the mirek range the light reports
+func validateLightColorTemperature(model *lightResourceModel, light *openhue.LightGet, diags *diag.Diagnostics) {
+	if model.ColorTemperature.IsNull() || model.ColorTemperature.IsUnknown() {
+		return
+	}
+
+	minimum, maximum, ok := lightMirekRange(light)
+	if !ok {
+		diags.AddAttributeError(
+			path.Root("color_temperature"),
+			"unsupported color temperature",
+			fmt.Sprintf("light %s does not support color temperatures", model.Name.ValueString()),
+		)
+		return
+	}
+
+	if mirek := model.ColorTemperature.ValueInt64(); mirek < minimum || mirek > maximum {
+		diags.AddAttributeError(
+			path.Root("color_temperature"),
+			"unsupported color temperature",
+			fmt.Sprintf("light %s supports color temperatures between %d and %d mirek, got %d", model.Name.ValueString(), minimum, maximum, mirek),
+		)
+	}
+}
+
+// lightMirekRange returns the color temperature range the light reports, if it supports color temperatures
+func lightMirekRange(light *openhue.LightGet) (int64, int64, bool) {
+	if light.ColorTemperature == nil || light.ColorTemperature.MirekSchema == nil {
+		return 0, 0, false
+	}
+
+	schema := light.ColorTemperature.MirekSchema
+	if schema.MirekMinimum == nil || schema.MirekMaximum == nil {
+		return 0, 0, false
+	}
+
+	return int64(*schema.MirekMinimum), int64(*schema.MirekMaximum), true
+}
+
+// planLightBrightness returns the light's minimum dim level if the planned brightness is below it.
+// The bridge silently raises such values, so they are sent at the minimum dim level instead.
+func planLightBrightness(model *lightResourceModel, light *openhue.LightGet) (float32, bool) {
+	if model.Brightness.IsNull() || model.Brightness.IsUnknown() {
+		return 0, false
+	}
+
+	if light.Dimming == nil || light.Dimming.MinDimLevel == nil {
+		return 0, false
+	}
+
+	minDimLevel := *light.Dimming.MinDimLevel
+	if model.Brightness.ValueFloat32() >= minDimLevel {
+		return 0, false
+	}
+
+	return minDimLevel, true
+}
+
+// validateLightGradient checks the planned gradient against the gradient capabilities of the light
+func validateLightGradient(model *lightResourceModel, light *openhue.LightGet, diags *diag.Diagnostics) {
+	if model.Gradient == nil {
+		return
+	}
+
+	if light.Gradient == nil || light.Gradient.PointsCapable == nil || *light.Gradient.PointsCapable == 0 {
+		diags.AddAttributeError(
+			path.Root("gradient"),
+			"unsupported gradient",
+			fmt.Sprintf("light %s does not support gradients", model.Name.ValueString()),
+		)
+		return
+	}
+
+	if len(model.Gradient.Points) > *light.Gradient.PointsCapable {
+		diags.AddAttributeError(
+			path.Root("gradient").AtName("points"),
+			"too many gradient points",
+			fmt.Sprintf("light %s supports at most %d gradient points, got %d", model.Name.ValueString(), *light.Gradient.PointsCapable, len(model.Gradient.Points)),
+		)
+	}
+
+	if !model.Gradient.Mode.IsNull() && !model.Gradient.Mode.IsUnknown() && light.Gradient.ModeValues != nil {
+		supported := []string{}
+		for _, mode := range *light.Gradient.ModeValues {
+			supported = append(supported, string(mode))
+		}
+
+		if !slices.Contains(supported, model.Gradient.Mode.ValueString()) {
+			diags.AddAttributeError(
+				path.Root("gradient").AtName("mode"),
+				"unsupported gradient mode",
+				fmt.Sprintf("light %s does not support the gradient mode %s. Supported modes: %s", model.Name.ValueString(), model.Gradient.Mode.ValueString(), strings.Join(supported, ", ")),
+			)
+		}
+	}
+}
+
+// validateLightPowerup checks that only custom presets carry custom settings, and that each mode has the value it needs
+func validateLightPowerup(powerup *lightResourceModelPowerup, diags *diag.Diagnostics) {
+	powerupPath := path.Root("powerup")
+
+	if powerup.Preset.IsUnknown() {
+		return
+	}
+
+	if powerup.Preset.ValueString() != string(openhue.PowerupPresetCustom) {
+		customAttributes := map[string]attr.Value{
+			"on_mode":           powerup.OnMode,
+			"on":                powerup.On,
+			"dimming_mode":      powerup.DimmingMode,
+			"brightness":        powerup.Brightness,
+			"color_mode":        powerup.ColorMode,
+			"color_temperature": powerup.ColorTemperature,
+			"color":             powerup.Color,
+		}
+
+		for name, value := range customAttributes {
+			if !value.IsNull() {
+				diags.AddAttributeError(powerupPath.AtName(name), "invalid powerup", fmt.Sprintf("%s can only be set when preset is custom", name))
+			}
+		}
+
+		return
+	}
+
+	if powerup.OnMode.IsNull() {
+		diags.AddAttributeError(powerupPath.AtName("on_mode"), "invalid powerup", "on_mode must be set when preset is custom")
+	}
+
+	if powerup.DimmingMode.ValueString() == string(openhue.PowerupDimmingModeDimming) && powerup.Brightness.IsNull() {
+		diags.AddAttributeError(powerupPath.AtName("brightness"), "invalid powerup", "brightness must be set when dimming_mode is dimming")
+	}
+
+	if powerup.ColorMode.ValueString() == string(openhue.PowerupDimmingColorModeColorTemperature) && powerup.ColorTemperature.IsNull() {
+		diags.AddAttributeError(powerupPath.AtName("color_temperature"), "invalid powerup", "color_temperature must be set when color_mode is color_temperature")
+	}
+
+	if powerup.ColorMode.ValueString() == string(openhue.PowerupDimmingColorModeColor) && powerup.Color.IsNull() {
+		diags.AddAttributeError(powerupPath.AtName("color"), "invalid powerup", "color must be set when color_mode is color")
+	}
+}
+
 func lightModelToPayload(model *lightResourceModel) (*openhue.LightPut, error) {
-	return &openhue.LightPut{
+	lightPut := &openhue.LightPut{
 		On: &openhue.On{
 			On: model.On.ValueBoolPointer(),
 		},
@@ -301,17 +998,278 @@ func lightModelToPayload(model *lightResourceModel) (*openhue.LightPut, error) {
 		Dimming: &openhue.Dimming{
 			Brightness: model.Brightness.ValueFloat32Pointer(),
 		},
-	}, nil
+	}
+
+	// The default color of 0, 0 lies outside every gamut and stands for no color, for example on white lights
+	if model.Color.X.ValueFloat32() == 0 && model.Color.Y.ValueFloat32() == 0 {
+		lightPut.Color = nil
+	}
+
+	if !model.ColorTemperature.IsNull() {
+		lightPut.Color = nil
+		lightPut.ColorTemperature = &openhue.ColorTemperature{
+			Mirek: util.IntPointer(int(model.ColorTemperature.ValueInt64())),
+		}
+	}
+
+	if !model.Effect.IsNull() {
+		effect := openhue.SupportedEffects(model.Effect.ValueString())
+		lightPut.Effects = &openhue.Effects{
+			Effect: &effect,
+		}
+	}
+
+	if model.TimedEffect != nil {
+		timedEffect := openhue.SupportedTimedEffects(model.TimedEffect.Name.ValueString())
+		lightPut.TimedEffects = &struct {
+			Duration *int                           "json:\"duration,omitempty\""
+			Effect   *openhue.SupportedTimedEffects "json:\"effect,omitempty\""
+		}{
+			Effect: &timedEffect,
+		}
+
+		if !model.TimedEffect.Duration.IsNull() {
+			lightPut.TimedEffects.Duration = util.IntPointer(int(model.TimedEffect.Duration.ValueInt64()))
+		}
+	}
+
+	if model.Gradient != nil {
+		points := make([]openhue.Color, len(model.Gradient.Points))
+		for i, point := range model.Gradient.Points {
+			x, y, _, err := util.HexToXyy(point.ValueString())
+			if err != nil {
+				return nil, fmt.Errorf("invalid gradient point %s: %w", point.ValueString(), err)
+			}
+
+			points[i] = openhue.Color{
+				Xy: &openhue.GamutPosition{
+					X: util.Float32Pointer(x),
+					Y: util.Float32Pointer(y),
+				},
+			}
+		}
+
+		lightPut.Gradient = &openhue.Gradient{
+			Points: &points,
+		}
+
+		if !model.Gradient.Mode.IsNull() {
+			mode := openhue.SupportedGradientMode(model.Gradient.Mode.ValueString())
+			lightPut.Gradient.Mode = &mode
+		}
+	}
+
+	return lightPut, nil
+}
+
+func lightPowerupModelToPayload(powerup *lightResourceModelPowerup) hue.LightPowerup {
+	payload := hue.LightPowerup{
+		Preset: powerup.Preset.ValueStringPointer(),
+	}
+
+	if powerup.Preset.ValueString() != string(openhue.PowerupPresetCustom) {
+		return payload
+	}
+
+	if !powerup.OnMode.IsNull() {
+		payload.On = &hue.LightPowerupOn{
+			Mode: powerup.OnMode.ValueStringPointer(),
+		}
+
+		if powerup.OnMode.ValueString() == string(openhue.PowerupOnModeOn) {
+			on := powerup.On.IsNull() || powerup.On.ValueBool()
+			payload.On.On = &openhue.On{On: &on}
+		}
+	}
+
+	if !powerup.DimmingMode.IsNull() {
+		payload.Dimming = &hue.LightPowerupDimming{
+			Mode: powerup.DimmingMode.ValueStringPointer(),
+		}
+
+		if powerup.DimmingMode.ValueString() == string(openhue.PowerupDimmingModeDimming) {
+			payload.Dimming.Dimming = &openhue.Dimming{Brightness: powerup.Brightness.ValueFloat32Pointer()}
+		}
+	}
+
+	if !powerup.ColorMode.IsNull() {
+		payload.Color = &hue.LightPowerupColor{
+			Mode: powerup.ColorMode.ValueStringPointer(),
+		}
+
+		switch powerup.ColorMode.ValueString() {
+		case string(openhue.PowerupDimmingColorModeColorTemperature):
+			payload.Color.ColorTemperature = &openhue.ColorTemperature{
+				Mirek: util.IntPointer(int(powerup.ColorTemperature.ValueInt64())),
+			}
+		case string(openhue.PowerupDimmingColorModeColor):
+			// The color has already been validated as hex by the schema
+			x, y, _, _ := util.HexToXyy(powerup.Color.ValueString())
+			payload.Color.Color = &openhue.Color{
+				Xy: &openhue.GamutPosition{
+					X: util.Float32Pointer(x),
+					Y: util.Float32Pointer(y),
+				},
+			}
+		}
+	}
+
+	return payload
+}
+
+// mapLightPowerupToModel reads back the powerup settings that are managed in the model.
+// The hex color cannot be recovered from the xy value the bridge reports, so it is kept as configured.
+func mapLightPowerupToModel(powerupModel *lightResourceModelPowerup, powerup *hue.LightPowerup) *lightResourceModelPowerup {
+	updated := *powerupModel
+	updated.Preset = types.StringPointerValue(powerup.Preset)
+
+	if powerup.Preset == nil || *powerup.Preset != string(openhue.PowerupPresetCustom) {
+		return &updated
+	}
+
+	if powerup.On != nil && !updated.OnMode.IsNull() {
+		updated.OnMode = types.StringPointerValue(powerup.On.Mode)
+
+		if powerup.On.On != nil && !updated.On.IsNull() {
+			updated.On = types.BoolPointerValue(powerup.On.On.On)
+		}
+	}
+
+	if powerup.Dimming != nil && !updated.DimmingMode.IsNull() {
+		updated.DimmingMode = types.StringPointerValue(powerup.Dimming.Mode)
+
+		if powerup.Dimming.Dimming != nil && !updated.Brightness.IsNull() {
+			updated.Brightness = types.Float32PointerValue(powerup.Dimming.Dimming.Brightness)
+		}
+	}
+
+	if powerup.Color != nil && !updated.ColorMode.IsNull() {
+		updated.ColorMode = types.StringPointerValue(powerup.Color.Mode)
+
+		if powerup.Color.ColorTemperature != nil && powerup.Color.ColorTemperature.Mirek != nil && !updated.ColorTemperature.IsNull() {
+			updated.ColorTemperature = types.Int64Value(int64(*powerup.Color.ColorTemperature.Mirek))
+		}
+	}
+
+	return &updated
 }
 
 func mapLightStateToModel(lightModel lightResourceModel, light *openhue.LightGet) lightResourceModel {
 	return lightResourceModel{
-		Name: types.StringPointerValue(light.Metadata.Name),
-		Id:   types.StringPointerValue(light.Id),
-		On:   types.BoolPointerValue(light.On.On),
-		Brightness: types.Float32PointerValue(
-			light.Dimming.Brightness,
-		),
-		Color: lightModel.Color,
+		Name:                mapLightNameToModel(lightModel, light),
+		Id:                  types.StringPointerValue(light.Id),
+		Room:                lightModel.Room,
+		Match:               mapLightMatchToModel(lightModel.Match),
+		On:                  types.BoolPointerValue(light.On.On),
+		Brightness:          mapLightBrightnessToModel(lightModel.Brightness, light),
+		Color:               lightModel.Color,
+		ColorTemperature:    mapLightColorTemperatureToModel(lightModel.ColorTemperature, light),
+		UnreachableBehavior: lightModel.UnreachableBehavior,
+		Reachable:           lightModel.Reachable,
+		Effect:              mapLightEffectToModel(lightModel.Effect, light),
+		TimedEffect:         mapLightTimedEffectToModel(lightModel.TimedEffect, light),
+		Gradient:            lightModel.Gradient,
+		Powerup:             lightModel.Powerup,
+	}
+}
+
+// mapLightBrightnessToModel reads back the brightness, keeping a configured brightness below the
+// light's minimum dim level while the light sits at that level, since the bridge raised it there
+func mapLightBrightnessToModel(brightness types.Float32, light *openhue.LightGet) types.Float32 {
+	if light.Dimming == nil {
+		return brightness
+	}
+
+	if brightness.IsNull() || light.Dimming.Brightness == nil || light.Dimming.MinDimLevel == nil {
+		return types.Float32PointerValue(light.Dimming.Brightness)
+	}
+
+	minDimLevel := *light.Dimming.MinDimLevel
+	if brightness.ValueFloat32() < minDimLevel && *light.Dimming.Brightness <= minDimLevel {
+		return brightness
+	}
+
+	return types.Float32PointerValue(light.Dimming.Brightness)
+}
+
+// mapLightColorTemperatureToModel reads back the color temperature, as long as it is managed and the
+// light is showing one. The bridge clamps color temperatures to the range of the light, so the
+// configured value is kept while the light shows it clamped.
+func mapLightColorTemperatureToModel(colorTemperature types.Int64, light *openhue.LightGet) types.Int64 {
+	if colorTemperature.IsNull() || light.ColorTemperature == nil || light.ColorTemperature.Mirek == nil {
+		return colorTemperature
+	}
+
+	if light.ColorTemperature.MirekValid != nil && !*light.ColorTemperature.MirekValid {
+		return colorTemperature
+	}
+
+	mirek := int64(*light.ColorTemperature.Mirek)
+	configured := colorTemperature.ValueInt64()
+	if minimum, maximum, ok := lightMirekRange(light); ok {
+		configured = min(max(configured, minimum), maximum)
+	}
+
+	if mirek == configured {
+		return colorTemperature
+	}
+
+	return types.Int64Value(mirek)
+}
+
+// mapLightNameToModel keeps the configured name as long as it still selects the light, so that
+// case insensitive and regex names do not drift to the name reported by the bridge
+func mapLightNameToModel(lightModel lightResourceModel, light *openhue.LightGet) types.String {
+	name := types.StringPointerValue(light.Metadata.Name)
+	if lightModel.Name.IsNull() || name.IsNull() {
+		return name
+	}
+
+	if lightSelectorFromModel(&lightModel).MatchesName(name.ValueString()) {
+		return lightModel.Name
+	}
+
+	return name
+}
+
+func mapLightMatchToModel(match types.String) types.String {
+	if match.IsNull() {
+		return types.StringValue(hue.LightMatchExact)
+	}
+
+	return match
+}
+
+// mapLightEffectToModel reads back the active effect, as long as effects are managed for the light
+func mapLightEffectToModel(effect types.String, light *openhue.LightGet) types.String {
+	if effect.IsNull() || light.Effects == nil {
+		return effect
+	}
+
+	if light.Effects.Status != nil {
+		return types.StringValue(string(*light.Effects.Status))
+	}
+
+	if light.Effects.Effect != nil {
+		return types.StringValue(string(*light.Effects.Effect))
+	}
+
+	return effect
+}
+
+// mapLightTimedEffectToModel reads back the running timed effect. Timed effects stop by themselves once
+// their duration has passed, so the configured one is kept in state when nothing is running.
+func mapLightTimedEffectToModel(timedEffect *lightResourceModelTimedEffect, light *openhue.LightGet) *lightResourceModelTimedEffect {
+	if timedEffect == nil || light.TimedEffects == nil || light.TimedEffects.Status == nil {
+		return timedEffect
+	}
+
+	if *light.TimedEffects.Status == openhue.SupportedTimedEffects(timedEffectNoEffect) {
+		return timedEffect
+	}
+
+	return &lightResourceModelTimedEffect{
+		Name:     types.StringValue(string(*light.TimedEffects.Status)),
+		Duration: timedEffect.Duration,
 	}
 }
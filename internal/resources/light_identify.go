@@ -0,0 +1,221 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openhue/openhue-go"
+	"github.com/ryanolee/terraform-provider-talk/internal/hue"
+	"github.com/ryanolee/terraform-provider-talk/internal/util"
+)
+
+type (
+	LightIdentify struct {
+		client *hue.Client
+	}
+
+	lightIdentifyResourceModel struct {
+		Id       types.String `tfsdk:"id"`
+		LightId  types.String `tfsdk:"light_id"`
+		Action   types.String `tfsdk:"action"`
+		Triggers types.Map    `tfsdk:"triggers"`
+	}
+)
+
+// Ways a light can be made to identify itself
+const (
+	lightIdentifyActionIdentify = "identify"
+	lightIdentifyActionBreathe  = "breathe"
+)
+
+func NewLightIdentify() resource.Resource {
+	return &LightIdentify{}
+}
+
+func (r *LightIdentify) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_light_identify", req.ProviderTypeName)
+}
+
+func (r *LightIdentify) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Configure can be called multiple times (sometimes without provider data)
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*hue.Client)
+	if !ok {
+		resp.Diagnostics.AddError("expected hue.Client", fmt.Sprintf("Expected *hue.Client, got %T", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *LightIdentify) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the light that was identified",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"light_id": schema.StringAttribute{
+				Description: "The ID of the light to identify",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"action": schema.StringAttribute{
+				Description: "How the light identifies itself. `identify` makes the device blink briefly, `breathe` plays a breathe alert on the light. Defaults to `identify`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(lightIdentifyActionIdentify),
+				Validators: []validator.String{
+					stringvalidator.OneOf(lightIdentifyActionIdentify, lightIdentifyActionBreathe),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that cause the light to identify itself again whenever they change",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Description: "Makes a light identify itself, for example so you can see which physical lamp a light resource refers to. The light identifies itself on create and whenever `triggers` change.",
+	}
+}
+
+func (r *LightIdentify) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to identify light", "client is nil")
+		return
+	}
+
+	var model lightIdentifyResourceModel
+
+	resp.Diagnostics.Append(
+		req.Plan.Get(ctx, &model)...,
+	)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Identifying light %s using %s", model.LightId.String(), model.Action.String()))
+
+	var err error
+	switch model.Action.ValueString() {
+	case lightIdentifyActionBreathe:
+		err = r.breathe(ctx, model.LightId.ValueString())
+	default:
+		err = r.identify(ctx, model.LightId.ValueString())
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("failed to identify light", fmt.Sprintf("failed to identify light: %s", err.Error()))
+		return
+	}
+
+	model.Id = model.LightId
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *LightIdentify) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// This is a no-op because identifying a light leaves nothing behind to read
+	return
+}
+
+func (r *LightIdentify) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model lightIdentifyResourceModel
+
+	resp.Diagnostics.Append(
+		req.Plan.Get(ctx, &model)...,
+	)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *LightIdentify) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// This is a no-op because identifying a light leaves nothing behind to delete
+	return
+}
+
+// identify sends the identify command to the device that owns the light
+func (r *LightIdentify) identify(ctx context.Context, lightId string) error {
+	lightResp, err := r.client.GetLightWithResponse(ctx, lightId)
+	if err != nil {
+		return err
+	}
+
+	if lightResp.HTTPResponse.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s, %s", lightResp.HTTPResponse.Status, string(lightResp.Body))
+	}
+
+	if lightResp.JSON200 == nil || lightResp.JSON200.Data == nil || len(*lightResp.JSON200.Data) == 0 {
+		return fmt.Errorf("no data in response body")
+	}
+
+	light := (*lightResp.JSON200.Data)[0]
+	if light.Owner == nil || light.Owner.Rid == nil {
+		return fmt.Errorf("light %s has no owning device", lightId)
+	}
+
+	identifyAction := openhue.Identify
+	deviceResp, err := r.client.UpdateDeviceWithResponse(ctx, *light.Owner.Rid, openhue.DevicePut{
+		Identify: &struct {
+			Action *openhue.DevicePutIdentifyAction "json:\"action,omitempty\""
+		}{
+			Action: &identifyAction,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if deviceResp.HTTPResponse.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s, %s", deviceResp.HTTPResponse.Status, string(deviceResp.Body))
+	}
+
+	return nil
+}
+
+// breathe plays the breathe alert on the light
+func (r *LightIdentify) breathe(ctx context.Context, lightId string) error {
+	apiResp, err := r.client.UpdateLightWithResponse(ctx, lightId, openhue.LightPut{
+		Alert: &openhue.Alert{
+			Action: util.StringPointer(lightIdentifyActionBreathe),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if apiResp.HTTPResponse.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s, %s", apiResp.HTTPResponse.Status, string(apiResp.Body))
+	}
+
+	return nil
+}
@@ -0,0 +1,397 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openhue/openhue-go"
+	"github.com/ryanolee/terraform-provider-talk/internal/hue"
+	"github.com/ryanolee/terraform-provider-talk/internal/util"
+)
+
+type (
+	MotionSensor struct {
+		client *hue.Client
+	}
+
+	motionSensorResourceModel struct {
+		Id             types.String `tfsdk:"id"`
+		DeviceName     types.String `tfsdk:"device_name"`
+		DeviceId       types.String `tfsdk:"device_id"`
+		Enabled        types.Bool   `tfsdk:"enabled"`
+		Sensitivity    types.Int64  `tfsdk:"sensitivity"`
+		SensitivityMax types.Int64  `tfsdk:"sensitivity_max"`
+		Motion         types.Bool   `tfsdk:"motion"`
+	}
+)
+
+func NewMotionSensor() resource.Resource {
+	return &MotionSensor{}
+}
+
+func (r *MotionSensor) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_motion_sensor", req.ProviderTypeName)
+}
+
+func (r *MotionSensor) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Configure can be called multiple times (sometimes without provider data)
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*hue.Client)
+	if !ok {
+		resp.Diagnostics.AddError("expected hue.Client", fmt.Sprintf("Expected *hue.Client, got %T", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *MotionSensor) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "The ID of the motion service. Either this or `device_name` must be set to pick the sensor to adopt.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"device_name": schema.StringAttribute{
+				Description: "The name of the device the motion service belongs to. This MUST match the name of the device in the Hue system exactly.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"device_id": schema.StringAttribute{
+				Description: "The ID of the device the motion service belongs to",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				Description: "Whether the motion sensor is enabled. Left as reported by the bridge if not set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sensitivity": schema.Int64Attribute{
+				Description: "The sensitivity of the motion sensor. Must be between 0 and `sensitivity_max`. Left as reported by the bridge if not set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"sensitivity_max": schema.Int64Attribute{
+				Description: "The maximum sensitivity the motion sensor supports",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"motion": schema.BoolAttribute{
+				Description: "Whether the sensor currently detects motion",
+				Computed:    true,
+			},
+		},
+		Description: "An existing motion sensor in the Hue system. The sensor is adopted on create and left in place on destroy.",
+	}
+}
+
+func (r *MotionSensor) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model motionSensorResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if model.Id.IsNull() && model.DeviceName.IsNull() {
+		resp.Diagnostics.AddError("invalid motion sensor", "one of id or device_name must be set")
+	}
+
+	if !model.Id.IsNull() && !model.DeviceName.IsNull() {
+		resp.Diagnostics.AddError("invalid motion sensor", "only one of id or device_name can be set")
+	}
+
+	if !model.Sensitivity.IsNull() && !model.Sensitivity.IsUnknown() && model.Sensitivity.ValueInt64() < 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("sensitivity"), "invalid sensitivity", "sensitivity must not be negative")
+	}
+}
+
+func (r *MotionSensor) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to create motion sensor", "client is nil")
+		return
+	}
+
+	var model motionSensorResourceModel
+
+	resp.Diagnostics.Append(
+		req.Plan.Get(ctx, &model)...,
+	)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if model.Id.IsUnknown() || model.Id.IsNull() {
+		motionId, err := r.findMotionIdByDeviceName(ctx, model.DeviceName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("failed to find motion sensor", fmt.Sprintf("failed to find motion sensor: %s", err.Error()))
+			return
+		}
+
+		model.Id = types.StringValue(motionId)
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Adopting motion sensor %s", model.Id.String()))
+
+	if err := r.updateMotionSensor(ctx, &model); err != nil {
+		resp.Diagnostics.AddError("failed to update motion sensor", fmt.Sprintf("failed to update motion sensor: %s", err.Error()))
+		return
+	}
+
+	if err := r.readMotionSensor(ctx, &model); err != nil {
+		resp.Diagnostics.AddError("failed to get motion sensor", fmt.Sprintf("failed to get motion sensor: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *MotionSensor) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to read motion sensor", "client is nil")
+		return
+	}
+
+	var model motionSensorResourceModel
+
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &model)...,
+	)
+
+	tflog.Info(ctx, fmt.Sprintf("Reading motion sensor %s", model.Id.String()))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.readMotionSensor(ctx, &model); err != nil {
+		resp.Diagnostics.AddError("failed to get motion sensor", fmt.Sprintf("failed to get motion sensor: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *MotionSensor) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to update motion sensor", "client is nil")
+		return
+	}
+
+	var model motionSensorResourceModel
+
+	resp.Diagnostics.Append(
+		req.Plan.Get(ctx, &model)...,
+	)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updating motion sensor %s", model.Id.String()))
+
+	if err := r.updateMotionSensor(ctx, &model); err != nil {
+		resp.Diagnostics.AddError("failed to update motion sensor", fmt.Sprintf("failed to update motion sensor: %s", err.Error()))
+		return
+	}
+
+	if err := r.readMotionSensor(ctx, &model); err != nil {
+		resp.Diagnostics.AddError("failed to get motion sensor", fmt.Sprintf("failed to get motion sensor: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *MotionSensor) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// This is a no-op because motion sensors cannot be deleted
+	return
+}
+
+func (r *MotionSensor) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// findMotionIdByDeviceName returns the ID of the motion service belonging to the device with the given name
+func (r *MotionSensor) findMotionIdByDeviceName(ctx context.Context, deviceName string) (string, error) {
+	apiResp, err := r.client.GetDevicesWithResponse(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if apiResp.HTTPResponse.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%s, %s", apiResp.HTTPResponse.Status, string(apiResp.Body))
+	}
+
+	if apiResp.JSON200 == nil || apiResp.JSON200.Data == nil {
+		return "", fmt.Errorf("no data in response body")
+	}
+
+	sensorNames := []string{}
+	for _, device := range *apiResp.JSON200.Data {
+		if device.Services == nil || device.Metadata == nil || device.Metadata.Name == nil {
+			continue
+		}
+
+		for _, service := range *device.Services {
+			if service.Rtype == nil || *service.Rtype != openhue.ResourceIdentifierRtypeMotion {
+				continue
+			}
+
+			if *device.Metadata.Name == deviceName {
+				return *service.Rid, nil
+			}
+
+			sensorNames = append(sensorNames, *device.Metadata.Name)
+			break
+		}
+	}
+
+	return "", fmt.Errorf("no motion sensor found on device %s. Available motion sensors %s", deviceName, strings.Join(sensorNames, ", "))
+}
+
+// updateMotionSensor sends any configured settings to the bridge, leaving unset ones untouched
+func (r *MotionSensor) updateMotionSensor(ctx context.Context, model *motionSensorResourceModel) error {
+	motionPut := openhue.MotionPut{}
+
+	if !model.Enabled.IsNull() && !model.Enabled.IsUnknown() {
+		motionPut.Enabled = model.Enabled.ValueBoolPointer()
+	}
+
+	if !model.Sensitivity.IsNull() && !model.Sensitivity.IsUnknown() {
+		sensor, err := r.getMotionSensor(ctx, model.Id.ValueString())
+		if err != nil {
+			return err
+		}
+
+		if sensor.Sensitivity != nil && sensor.Sensitivity.SensitivityMax != nil && model.Sensitivity.ValueInt64() > int64(*sensor.Sensitivity.SensitivityMax) {
+			return fmt.Errorf("sensitivity %d is above the maximum of %d supported by the sensor", model.Sensitivity.ValueInt64(), *sensor.Sensitivity.SensitivityMax)
+		}
+
+		motionPut.Sensitivity = &struct {
+			Sensitivity *int "json:\"sensitivity,omitempty\""
+		}{
+			Sensitivity: util.IntPointer(int(model.Sensitivity.ValueInt64())),
+		}
+	}
+
+	if motionPut.Enabled == nil && motionPut.Sensitivity == nil {
+		return nil
+	}
+
+	apiResp, err := r.client.UpdateMotionSensorWithResponse(ctx, model.Id.ValueString(), motionPut)
+	if err != nil {
+		return err
+	}
+
+	if apiResp.HTTPResponse.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s, %s", apiResp.HTTPResponse.Status, string(apiResp.Body))
+	}
+
+	return nil
+}
+
+// readMotionSensor refreshes the model with the state reported by the bridge
+func (r *MotionSensor) readMotionSensor(ctx context.Context, model *motionSensorResourceModel) error {
+	sensor, err := r.getMotionSensor(ctx, model.Id.ValueString())
+	if err != nil {
+		return err
+	}
+
+	model.Id = types.StringPointerValue(sensor.Id)
+	model.Enabled = types.BoolPointerValue(sensor.Enabled)
+	model.Motion = types.BoolNull()
+	if sensor.Motion != nil {
+		model.Motion = types.BoolPointerValue(sensor.Motion.Motion)
+	}
+
+	model.Sensitivity = types.Int64Null()
+	model.SensitivityMax = types.Int64Null()
+	if sensor.Sensitivity != nil {
+		if sensor.Sensitivity.Sensitivity != nil {
+			model.Sensitivity = types.Int64Value(int64(*sensor.Sensitivity.Sensitivity))
+		}
+
+		if sensor.Sensitivity.SensitivityMax != nil {
+			model.SensitivityMax = types.Int64Value(int64(*sensor.Sensitivity.SensitivityMax))
+		}
+	}
+
+	model.DeviceId = types.StringNull()
+	if sensor.Owner == nil || sensor.Owner.Rid == nil {
+		return nil
+	}
+
+	model.DeviceId = types.StringPointerValue(sensor.Owner.Rid)
+
+	deviceResp, err := r.client.GetDeviceWithResponse(ctx, *sensor.Owner.Rid)
+	if err != nil {
+		return err
+	}
+
+	if deviceResp.HTTPResponse.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s, %s", deviceResp.HTTPResponse.Status, string(deviceResp.Body))
+	}
+
+	if deviceResp.JSON200 != nil && deviceResp.JSON200.Data != nil && len(*deviceResp.JSON200.Data) > 0 {
+		device := (*deviceResp.JSON200.Data)[0]
+		if device.Metadata != nil {
+			model.DeviceName = types.StringPointerValue(device.Metadata.Name)
+		}
+	}
+
+	return nil
+}
+
+func (r *MotionSensor) getMotionSensor(ctx context.Context, motionId string) (*openhue.MotionGet, error) {
+	apiResp, err := r.client.GetMotionSensorWithResponse(ctx, motionId)
+	if err != nil {
+		return nil, err
+	}
+
+	if apiResp.HTTPResponse.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s, %s", apiResp.HTTPResponse.Status, string(apiResp.Body))
+	}
+
+	if apiResp.JSON200 == nil || apiResp.JSON200.Data == nil || len(*apiResp.JSON200.Data) == 0 {
+		return nil, fmt.Errorf("no data in response body")
+	}
+
+	return &(*apiResp.JSON200.Data)[0], nil
+}
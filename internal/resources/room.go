@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -13,12 +14,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/openhue/openhue-go"
+	"github.com/ryanolee/terraform-provider-talk/internal/hue"
 	"github.com/ryanolee/terraform-provider-talk/internal/util"
 )
 
 type (
 	Room struct {
-		client *openhue.ClientWithResponses
+		client *hue.Client
 	}
 
 	RoomResourceModel struct {
@@ -43,9 +45,9 @@ func (r *Room) Configure(ctx context.Context, req resource.ConfigureRequest, res
 		return
 	}
 
-	client, ok := req.ProviderData.(*openhue.ClientWithResponses)
+	client, ok := req.ProviderData.(*hue.Client)
 	if !ok {
-		resp.Diagnostics.AddError("expected openhue.ClientWithResponses", fmt.Sprintf("Expected *openhue.Client, got %T", req.ProviderData))
+		resp.Diagnostics.AddError("expected hue.Client", fmt.Sprintf("Expected *hue.Client, got %T", req.ProviderData))
 		return
 	}
 
@@ -258,13 +260,51 @@ func (r *Room) Delete(ctx context.Context, req resource.DeleteRequest, resp *res
 	return
 }
 
+func (r *Room) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
 var stringToRoomArchetype = map[string]openhue.RoomArchetype{
-	"bedroom":  openhue.RoomArchetypeBedroom,
-	"bathroom": openhue.RoomArchetypeBathroom,
-	"kitchen":  openhue.RoomArchetypeKitchen,
-	"dining":   openhue.RoomArchetypeDining,
-	"office":   openhue.RoomArchetypeOffice,
-	"other":    openhue.RoomArchetypeOther,
+	"attic":        openhue.RoomArchetypeAttic,
+	"balcony":      openhue.RoomArchetypeBalcony,
+	"barbecue":     openhue.RoomArchetypeBarbecue,
+	"bathroom":     openhue.RoomArchetypeBathroom,
+	"bedroom":      openhue.RoomArchetypeBedroom,
+	"carport":      openhue.RoomArchetypeCarport,
+	"closet":       openhue.RoomArchetypeCloset,
+	"computer":     openhue.RoomArchetypeComputer,
+	"dining":       openhue.RoomArchetypeDining,
+	"downstairs":   openhue.RoomArchetypeDownstairs,
+	"driveway":     openhue.RoomArchetypeDriveway,
+	"front_door":   openhue.RoomArchetypeFrontDoor,
+	"garage":       openhue.RoomArchetypeGarage,
+	"garden":       openhue.RoomArchetypeGarden,
+	"guest_room":   openhue.RoomArchetypeGuestRoom,
+	"gym":          openhue.RoomArchetypeGym,
+	"hallway":      openhue.RoomArchetypeHallway,
+	"home":         openhue.RoomArchetypeHome,
+	"kids_bedroom": openhue.RoomArchetypeKidsBedroom,
+	"kitchen":      openhue.RoomArchetypeKitchen,
+	"laundry_room": openhue.RoomArchetypeLaundryRoom,
+	"living_room":  openhue.RoomArchetypeLivingRoom,
+	"lounge":       openhue.RoomArchetypeLounge,
+	"man_cave":     openhue.RoomArchetypeManCave,
+	"music":        openhue.RoomArchetypeMusic,
+	"nursery":      openhue.RoomArchetypeNursery,
+	"office":       openhue.RoomArchetypeOffice,
+	"other":        openhue.RoomArchetypeOther,
+	"pool":         openhue.RoomArchetypePool,
+	"porch":        openhue.RoomArchetypePorch,
+	"reading":      openhue.RoomArchetypeReading,
+	"recreation":   openhue.RoomArchetypeRecreation,
+	"staircase":    openhue.RoomArchetypeStaircase,
+	"storage":      openhue.RoomArchetypeStorage,
+	"studio":       openhue.RoomArchetypeStudio,
+	"terrace":      openhue.RoomArchetypeTerrace,
+	"toilet":       openhue.RoomArchetypeToilet,
+	"top_floor":    openhue.RoomArchetypeTopFloor,
+	"tv":           openhue.RoomArchetypeTv,
+	"upstairs":     openhue.RoomArchetypeUpstairs,
 }
 
 func mapStringToRoomArchetype(archetype string) (*openhue.RoomArchetype, bool) {
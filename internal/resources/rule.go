@@ -0,0 +1,381 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ryanolee/terraform-provider-talk/internal/hue"
+	"github.com/ryanolee/terraform-provider-talk/internal/util"
+)
+
+// ruleMaxItems is the most conditions and actions the bridge accepts on a single rule
+const ruleMaxItems = 8
+
+type (
+	Rule struct {
+		client *hue.Client
+	}
+
+	ruleResourceModel struct {
+		Id         types.String                 `tfsdk:"id"`
+		Name       types.String                 `tfsdk:"name"`
+		Status     types.String                 `tfsdk:"status"`
+		Conditions []ruleResourceModelCondition `tfsdk:"condition"`
+		Actions    []ruleResourceModelAction    `tfsdk:"action"`
+	}
+
+	ruleResourceModelCondition struct {
+		Address  types.String `tfsdk:"address"`
+		Operator types.String `tfsdk:"operator"`
+		Value    types.String `tfsdk:"value"`
+	}
+
+	ruleResourceModelAction struct {
+		Address types.String `tfsdk:"address"`
+		Method  types.String `tfsdk:"method"`
+		Body    types.String `tfsdk:"body"`
+	}
+)
+
+func NewRule() resource.Resource {
+	return &Rule{}
+}
+
+func (r *Rule) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_rule", req.ProviderTypeName)
+}
+
+func (r *Rule) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Configure can be called multiple times (sometimes without provider data)
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*hue.Client)
+	if !ok {
+		resp.Diagnostics.AddError("expected hue.Client", fmt.Sprintf("Expected *hue.Client, got %T", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *Rule) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	addressValidator := stringvalidator.RegexMatches(regexp.MustCompile(`^/`), "must start with /")
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The v1 ID of the rule",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the rule",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Whether the rule is `enabled` or `disabled`. Defaults to `enabled`. The bridge disables rules that reference a deleted resource, which is planned as a change back to the configured status.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(hue.RuleStatusEnabled),
+				Validators: []validator.String{
+					stringvalidator.OneOf(hue.RuleStatusEnabled, hue.RuleStatusDisabled),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"condition": schema.ListNestedBlock{
+				Description: "The conditions that must all be met for the rule to fire",
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(1, ruleMaxItems),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							Description: "The v1 address of the attribute to check, for example `/sensors/2/state/buttonevent` or `/config/localtime`",
+							Required:    true,
+							Validators: []validator.String{
+								addressValidator,
+							},
+						},
+						"operator": schema.StringAttribute{
+							Description: "How the attribute is checked, one of `eq`, `gt`, `lt`, `dx`, `ddx`, `stable`, `not stable`, `in` or `not in`",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(hue.RuleOperators...),
+							},
+						},
+						"value": schema.StringAttribute{
+							Description: "The value to compare against, for example `true`, `2002` or `T08:00:00/T20:00:00` for `in`. Must be left out for `dx`, `ddx`, `stable` and `not stable`.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"action": schema.ListNestedBlock{
+				Description: "The requests the rule sends when it fires",
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeBetween(1, ruleMaxItems),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"address": schema.StringAttribute{
+							Description: "The v1 address the request is sent to, for example `/groups/1/action` or `/sensors/5/state`",
+							Required:    true,
+							Validators: []validator.String{
+								addressValidator,
+							},
+						},
+						"method": schema.StringAttribute{
+							Description: "The HTTP method of the request, one of `PUT`, `POST` or `DELETE`. Defaults to `PUT`.",
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString(http.MethodPut),
+							Validators: []validator.String{
+								stringvalidator.OneOf(http.MethodPut, http.MethodPost, http.MethodDelete),
+							},
+						},
+						"body": schema.StringAttribute{
+							Description: "The JSON body of the request, for example `jsonencode({ scene = \"abc123\" })`",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+		Description: "A rule on the legacy v1 API, which sends requests to the bridge once all of its conditions are met. Use it for automations CLIP v2 does not offer, such as reacting to CLIP sensors.",
+	}
+}
+
+func (r *Rule) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model ruleResourceModel
+
+	// The blocks cannot be checked until they are known, for example while they are built with dynamic blocks
+	if diags := req.Config.Get(ctx, &model); diags.HasError() {
+		return
+	}
+
+	for i, condition := range model.Conditions {
+		if condition.Operator.IsNull() || condition.Operator.IsUnknown() {
+			continue
+		}
+
+		valuePath := path.Root("condition").AtListIndex(i).AtName("value")
+		takesValue := hue.RuleOperatorTakesValue(condition.Operator.ValueString())
+
+		if takesValue && condition.Value.IsNull() {
+			resp.Diagnostics.AddAttributeError(valuePath, "missing condition value", fmt.Sprintf("value must be set for the %s operator", condition.Operator.String()))
+		}
+
+		if !takesValue && !condition.Value.IsNull() {
+			resp.Diagnostics.AddAttributeError(valuePath, "unexpected condition value", fmt.Sprintf("value cannot be set for the %s operator", condition.Operator.String()))
+		}
+	}
+
+	for i, action := range model.Actions {
+		if action.Body.IsNull() || action.Body.IsUnknown() {
+			continue
+		}
+
+		if !json.Valid([]byte(action.Body.ValueString())) {
+			resp.Diagnostics.AddAttributeError(path.Root("action").AtListIndex(i).AtName("body"), "invalid action body", "body must be valid JSON")
+		}
+	}
+}
+
+func (r *Rule) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to create rule", "client is nil")
+		return
+	}
+
+	var model ruleResourceModel
+
+	resp.Diagnostics.Append(
+		req.Plan.Get(ctx, &model)...,
+	)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Creating rule %s", model.Name.String()))
+
+	id, err := r.client.V1.CreateRule(ctx, ruleModelToPayload(&model))
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create rule", fmt.Sprintf("failed to create rule: %s", err.Error()))
+		return
+	}
+
+	model.Id = types.StringValue(id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *Rule) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to read rule", "client is nil")
+		return
+	}
+
+	var model ruleResourceModel
+
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &model)...,
+	)
+
+	tflog.Info(ctx, fmt.Sprintf("Reading rule %s", model.Id.String()))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rule, err := r.client.V1.GetRule(ctx, model.Id.ValueString())
+	if hue.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get rule", fmt.Sprintf("failed to get rule: %s", err.Error()))
+		return
+	}
+
+	model = mapRuleToModel(model, rule)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *Rule) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to update rule", "client is nil")
+		return
+	}
+
+	var model ruleResourceModel
+
+	resp.Diagnostics.Append(
+		req.Plan.Get(ctx, &model)...,
+	)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updating rule %s", model.Id.String()))
+
+	if err := r.client.V1.UpdateRule(ctx, model.Id.ValueString(), ruleModelToPayload(&model)); err != nil {
+		resp.Diagnostics.AddError("failed to update rule", fmt.Sprintf("failed to update rule: %s", err.Error()))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *Rule) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to delete rule", "client is nil")
+		return
+	}
+
+	var model ruleResourceModel
+
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &model)...,
+	)
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting rule %s", model.Id.String()))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.V1.DeleteRule(ctx, model.Id.ValueString()); err != nil && !hue.IsNotFound(err) {
+		resp.Diagnostics.AddError("failed to delete rule", fmt.Sprintf("failed to delete rule: %s", err.Error()))
+		return
+	}
+}
+
+func (r *Rule) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func ruleModelToPayload(model *ruleResourceModel) hue.Rule {
+	rule := hue.Rule{
+		Name:   model.Name.ValueStringPointer(),
+		Status: model.Status.ValueStringPointer(),
+	}
+
+	for _, condition := range model.Conditions {
+		rule.Conditions = append(rule.Conditions, hue.RuleCondition{
+			Address:  condition.Address.ValueString(),
+			Operator: condition.Operator.ValueString(),
+			Value:    condition.Value.ValueStringPointer(),
+		})
+	}
+
+	for _, action := range model.Actions {
+		rule.Actions = append(rule.Actions, hue.RuleAction{
+			Address: action.Address.ValueString(),
+			Method:  action.Method.ValueString(),
+			Body:    json.RawMessage(action.Body.ValueString()),
+		})
+	}
+
+	return rule
+}
+
+func mapRuleToModel(ruleModel ruleResourceModel, rule *hue.Rule) ruleResourceModel {
+	model := ruleResourceModel{
+		Id:     ruleModel.Id,
+		Name:   types.StringPointerValue(rule.Name),
+		Status: types.StringPointerValue(rule.Status),
+	}
+
+	for _, condition := range rule.Conditions {
+		model.Conditions = append(model.Conditions, ruleResourceModelCondition{
+			Address:  types.StringValue(condition.Address),
+			Operator: types.StringValue(condition.Operator),
+			Value:    types.StringPointerValue(condition.Value),
+		})
+	}
+
+	for i, action := range rule.Actions {
+		actionModel := ruleResourceModelAction{
+			Address: types.StringValue(action.Address),
+			Method:  types.StringValue(action.Method),
+			Body:    types.StringValue(string(action.Body)),
+		}
+
+		// Keep the configured formatting of the body as long as it holds the same value
+		if i < len(ruleModel.Actions) && util.JsonEqual(ruleModel.Actions[i].Body.ValueString(), string(action.Body)) {
+			actionModel.Body = ruleModel.Actions[i].Body
+		}
+
+		model.Actions = append(model.Actions, actionModel)
+	}
+
+	return model
+}
@@ -0,0 +1,855 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openhue/openhue-go"
+	"github.com/ryanolee/terraform-provider-talk/internal/hue"
+	"github.com/ryanolee/terraform-provider-talk/internal/util"
+)
+
+type (
+	Scene struct {
+		client *hue.Client
+	}
+
+	sceneResourceModel struct {
+		Id          types.String               `tfsdk:"id"`
+		Name        types.String               `tfsdk:"name"`
+		GroupId     types.String               `tfsdk:"group_id"`
+		GroupType   types.String               `tfsdk:"group_type"`
+		Actions     []sceneResourceModelAction `tfsdk:"actions"`
+		Palette     *sceneResourceModelPalette `tfsdk:"palette"`
+		Speed       types.Float32              `tfsdk:"speed"`
+		AutoDynamic types.Bool                 `tfsdk:"auto_dynamic"`
+	}
+
+	sceneResourceModelAction struct {
+		TargetId         types.String  `tfsdk:"target_id"`
+		On               types.Bool    `tfsdk:"on"`
+		Brightness       types.Float32 `tfsdk:"brightness"`
+		Color            types.String  `tfsdk:"color"`
+		ColorTemperature types.Int64   `tfsdk:"color_temperature"`
+		Effect           types.String  `tfsdk:"effect"`
+	}
+
+	sceneResourceModelPalette struct {
+		Colors            []sceneResourceModelPaletteColor            `tfsdk:"colors"`
+		ColorTemperatures []sceneResourceModelPaletteColorTemperature `tfsdk:"color_temperatures"`
+		Brightness        []types.Float32                             `tfsdk:"brightness"`
+		Effects           []types.String                              `tfsdk:"effects"`
+	}
+
+	sceneResourceModelPaletteColor struct {
+		Color      types.String  `tfsdk:"color"`
+		Brightness types.Float32 `tfsdk:"brightness"`
+	}
+
+	sceneResourceModelPaletteColorTemperature struct {
+		Mirek      types.Int64   `tfsdk:"mirek"`
+		Brightness types.Float32 `tfsdk:"brightness"`
+	}
+)
+
+func NewScene() resource.Resource {
+	return &Scene{}
+}
+
+func (r *Scene) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_scene", req.ProviderTypeName)
+}
+
+func (r *Scene) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Configure can be called multiple times (sometimes without provider data)
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*hue.Client)
+	if !ok {
+		resp.Diagnostics.AddError("expected hue.Client", fmt.Sprintf("Expected *hue.Client, got %T", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *Scene) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	hexColorValidator := stringvalidator.RegexMatches(hexColorRegex, "must be a hex color such as #ff0000")
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the scene",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the scene",
+				Required:    true,
+			},
+			"group_id": schema.StringAttribute{
+				Description: "The ID of the room or zone the scene belongs to",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group_type": schema.StringAttribute{
+				Description: "The type of group `group_id` refers to, either `room` or `zone`. Defaults to `room`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(string(openhue.ResourceIdentifierRtypeRoom)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(openhue.ResourceIdentifierRtypeRoom), string(openhue.ResourceIdentifierRtypeZone)),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"actions": schema.ListNestedAttribute{
+				Description: "The state each light is put in when the scene is recalled",
+				Required:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"target_id": schema.StringAttribute{
+							Description: "The ID of the light the action applies to",
+							Required:    true,
+						},
+						"on": schema.BoolAttribute{
+							Description: "Whether the light is turned on",
+							Optional:    true,
+						},
+						"brightness": schema.Float32Attribute{
+							Description: "The brightness of the light in percent",
+							Optional:    true,
+							Validators: []validator.Float32{
+								float32validator.Between(0, 100),
+							},
+						},
+						"color": schema.StringAttribute{
+							Description: "The hex color of the light, for example `#ff0000`. Conflicts with `color_temperature`.",
+							Optional:    true,
+							Validators: []validator.String{
+								hexColorValidator,
+								stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("color_temperature")),
+							},
+						},
+						"color_temperature": schema.Int64Attribute{
+							Description: "The color temperature of the light in mirek",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.Between(153, 500),
+							},
+						},
+						"effect": schema.StringAttribute{
+							Description: "The effect to play on the light, for example `candle`",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"palette": schema.SingleNestedAttribute{
+				Description: "The colors the scene cycles through when played dynamically. Left as is if not set, in which case the palette reported by the bridge is kept in state.",
+				Optional:    true,
+				Computed:    true,
+				Attributes: map[string]schema.Attribute{
+					"colors": schema.ListNestedAttribute{
+						Description: "Up to 9 colors, each with an optional brightness",
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(9),
+						},
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"color": schema.StringAttribute{
+									Description: "The hex color, for example `#ff0000`",
+									Required:    true,
+									Validators: []validator.String{
+										hexColorValidator,
+									},
+								},
+								"brightness": schema.Float32Attribute{
+									Description: "The brightness of the color in percent",
+									Optional:    true,
+									Validators: []validator.Float32{
+										float32validator.Between(0, 100),
+									},
+								},
+							},
+						},
+					},
+					"color_temperatures": schema.ListNestedAttribute{
+						Description: "A color temperature, with an optional brightness",
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(1),
+						},
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"mirek": schema.Int64Attribute{
+									Description: "The color temperature in mirek",
+									Required:    true,
+									Validators: []validator.Int64{
+										int64validator.Between(153, 500),
+									},
+								},
+								"brightness": schema.Float32Attribute{
+									Description: "The brightness of the color temperature in percent",
+									Optional:    true,
+									Validators: []validator.Float32{
+										float32validator.Between(0, 100),
+									},
+								},
+							},
+						},
+					},
+					"brightness": schema.ListAttribute{
+						Description: "A brightness in percent",
+						ElementType: types.Float32Type,
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(1),
+							listvalidator.ValueFloat32sAre(float32validator.Between(0, 100)),
+						},
+					},
+					"effects": schema.ListAttribute{
+						Description: "Up to 3 effects to cycle through",
+						ElementType: types.StringType,
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(3),
+						},
+					},
+				},
+			},
+			"speed": schema.Float32Attribute{
+				Description: "The speed at which the palette is played, between 0 and 1. Left as chosen by the bridge if not set.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Float32{
+					float32validator.Between(0, 1),
+				},
+				PlanModifiers: []planmodifier.Float32{
+					float32planmodifier.UseStateForUnknown(),
+				},
+			},
+			"auto_dynamic": schema.BoolAttribute{
+				Description: "Whether the scene starts playing dynamically when it is recalled. Left as chosen by the bridge if not set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Description: "A scene in the Hue system, including its palette for dynamic playback",
+	}
+}
+
+func (r *Scene) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when the scene is being removed
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var palette types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("palette"), &palette)...)
+	if resp.Diagnostics.HasError() || !palette.IsNull() {
+		return
+	}
+
+	// An unmanaged palette keeps the one read from the bridge, and is left empty for new scenes
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("palette"), &palette)...)
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("palette"), palette)...)
+}
+
+func (r *Scene) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to create scene", "client is nil")
+		return
+	}
+
+	var model sceneResourceModel
+
+	resp.Diagnostics.Append(
+		req.Plan.Get(ctx, &model)...,
+	)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Creating scene %s", model.Name.String()))
+
+	putData, err := buildScenePutPayload(&model)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create scene", fmt.Sprintf("failed to create scene: %s", err.Error()))
+		return
+	}
+
+	sceneType := openhue.ScenePostTypeScene
+	apiResp, err := r.client.CreateSceneWithResponse(ctx, openhue.ScenePost{
+		Type:        &sceneType,
+		Actions:     *putData.Actions,
+		AutoDynamic: putData.AutoDynamic,
+		Group: openhue.ResourceIdentifier{
+			Rid:   model.GroupId.ValueStringPointer(),
+			Rtype: (*openhue.ResourceIdentifierRtype)(model.GroupType.ValueStringPointer()),
+		},
+		Metadata: *putData.Metadata,
+		Palette:  putData.Palette,
+		Speed:    putData.Speed,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create scene", fmt.Sprintf("failed to create scene: %s", err.Error()))
+		return
+	}
+
+	if apiResp.HTTPResponse.StatusCode != http.StatusOK && apiResp.HTTPResponse.StatusCode != http.StatusCreated {
+		resp.Diagnostics.AddError("failed to create scene", fmt.Sprintf("failed to create scene: %s, %s", apiResp.HTTPResponse.Status, string(apiResp.Body)))
+		return
+	}
+
+	// The generated client only decodes 200 responses, so decode the body ourselves to cover 201 as well
+	var created struct {
+		Data []openhue.ResourceIdentifier `json:"data"`
+	}
+
+	if err := json.Unmarshal(apiResp.Body, &created); err != nil || len(created.Data) == 0 {
+		resp.Diagnostics.AddError("failed to create scene", "failed to create scene: no data in response body")
+		return
+	}
+
+	model.Id = types.StringPointerValue(created.Data[0].Rid)
+
+	scene, err := r.getScene(ctx, model.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get scene", fmt.Sprintf("failed to get scene: %s", err.Error()))
+		return
+	}
+
+	// Fill in the dynamics left to the bridge
+	model.Speed = types.Float32PointerValue(scene.Speed)
+	model.AutoDynamic = types.BoolPointerValue(scene.AutoDynamic)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *Scene) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to read scene", "client is nil")
+		return
+	}
+
+	var model sceneResourceModel
+
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &model)...,
+	)
+
+	tflog.Info(ctx, fmt.Sprintf("Reading scene %s", model.Id.String()))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	scene, err := r.getScene(ctx, model.Id.ValueString())
+	if hue.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get scene", fmt.Sprintf("failed to get scene: %s", err.Error()))
+		return
+	}
+
+	model = mapSceneToResourceModel(model, scene)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *Scene) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to update scene", "client is nil")
+		return
+	}
+
+	var model sceneResourceModel
+
+	resp.Diagnostics.Append(
+		req.Plan.Get(ctx, &model)...,
+	)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updating scene %s", model.Id.String()))
+
+	putData, err := buildScenePutPayload(&model)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update scene", fmt.Sprintf("failed to update scene: %s", err.Error()))
+		return
+	}
+
+	apiResp, err := r.client.UpdateSceneWithResponse(ctx, model.Id.ValueString(), putData)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update scene", fmt.Sprintf("failed to update scene: %s", err.Error()))
+		return
+	}
+
+	if apiResp.HTTPResponse.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("failed to update scene", fmt.Sprintf("failed to update scene: %s, %s", apiResp.HTTPResponse.Status, string(apiResp.Body)))
+		return
+	}
+
+	scene, err := r.getScene(ctx, model.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get scene", fmt.Sprintf("failed to get scene: %s", err.Error()))
+		return
+	}
+
+	if model.Speed.IsUnknown() {
+		model.Speed = types.Float32PointerValue(scene.Speed)
+	}
+
+	if model.AutoDynamic.IsUnknown() {
+		model.AutoDynamic = types.BoolPointerValue(scene.AutoDynamic)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *Scene) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to delete scene", "client is nil")
+		return
+	}
+
+	var model sceneResourceModel
+
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &model)...,
+	)
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting scene %s", model.Id.String()))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.client.DeleteSceneWithResponse(ctx, model.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to delete scene", fmt.Sprintf("failed to delete scene: %s", err.Error()))
+		return
+	}
+
+	if apiResp.HTTPResponse.StatusCode != http.StatusOK && apiResp.HTTPResponse.StatusCode != http.StatusNotFound {
+		resp.Diagnostics.AddError("failed to delete scene", fmt.Sprintf("failed to delete scene: %s, %s", apiResp.HTTPResponse.Status, string(apiResp.Body)))
+		return
+	}
+}
+
+func (r *Scene) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// getScene fetches a scene from the bridge, returning a hue.ApiError if the bridge rejects the request
+func (r *Scene) getScene(ctx context.Context, sceneId string) (*openhue.SceneGet, error) {
+	apiResp, err := r.client.GetSceneWithResponse(ctx, sceneId)
+	if err != nil {
+		return nil, err
+	}
+
+	if apiResp.HTTPResponse.StatusCode != http.StatusOK {
+		return nil, &hue.ApiError{StatusCode: apiResp.HTTPResponse.StatusCode, Status: apiResp.HTTPResponse.Status, Body: string(apiResp.Body)}
+	}
+
+	if apiResp.JSON200 == nil || apiResp.JSON200.Data == nil || len(*apiResp.JSON200.Data) == 0 {
+		return nil, fmt.Errorf("no data in response body")
+	}
+
+	return &(*apiResp.JSON200.Data)[0], nil
+}
+
+func buildScenePutPayload(model *sceneResourceModel) (openhue.ScenePut, error) {
+	actions := []openhue.ActionPost{}
+	for _, actionModel := range model.Actions {
+		action := openhue.ActionPost{
+			Target: openhue.ResourceIdentifier{
+				Rid:   actionModel.TargetId.ValueStringPointer(),
+				Rtype: (*openhue.ResourceIdentifierRtype)(util.StringPointer("light")),
+			},
+		}
+
+		if !actionModel.On.IsNull() {
+			action.Action.On = &openhue.On{On: actionModel.On.ValueBoolPointer()}
+		}
+
+		if !actionModel.Brightness.IsNull() {
+			action.Action.Dimming = &openhue.Dimming{Brightness: actionModel.Brightness.ValueFloat32Pointer()}
+		}
+
+		if !actionModel.Color.IsNull() {
+			color, err := hexToColor(actionModel.Color.ValueString())
+			if err != nil {
+				return openhue.ScenePut{}, err
+			}
+
+			action.Action.Color = color
+		}
+
+		if !actionModel.ColorTemperature.IsNull() {
+			action.Action.ColorTemperature = &struct {
+				Mirek *openhue.Mirek `json:"mirek,omitempty"`
+			}{
+				Mirek: util.IntPointer(int(actionModel.ColorTemperature.ValueInt64())),
+			}
+		}
+
+		if !actionModel.Effect.IsNull() {
+			effect := openhue.SupportedEffects(actionModel.Effect.ValueString())
+			action.Action.Effects = &struct {
+				Effect *openhue.SupportedEffects `json:"effect,omitempty"`
+			}{
+				Effect: &effect,
+			}
+		}
+
+		actions = append(actions, action)
+	}
+
+	putData := openhue.ScenePut{
+		Actions: &actions,
+		Metadata: &openhue.SceneMetadata{
+			Name: model.Name.ValueStringPointer(),
+		},
+	}
+
+	if model.Palette != nil {
+		palette, err := buildScenePalettePayload(model.Palette)
+		if err != nil {
+			return openhue.ScenePut{}, err
+		}
+
+		putData.Palette = palette
+	}
+
+	if !model.Speed.IsNull() && !model.Speed.IsUnknown() {
+		putData.Speed = model.Speed.ValueFloat32Pointer()
+	}
+
+	if !model.AutoDynamic.IsNull() && !model.AutoDynamic.IsUnknown() {
+		putData.AutoDynamic = model.AutoDynamic.ValueBoolPointer()
+	}
+
+	return putData, nil
+}
+
+func buildScenePalettePayload(paletteModel *sceneResourceModelPalette) (*openhue.ScenePalette, error) {
+	colors := []openhue.ColorPaletteGet{}
+	for _, colorModel := range paletteModel.Colors {
+		color, err := hexToColor(colorModel.Color.ValueString())
+		if err != nil {
+			return nil, err
+		}
+
+		paletteColor := openhue.ColorPaletteGet{Color: color}
+		if !colorModel.Brightness.IsNull() {
+			paletteColor.Dimming = &openhue.Dimming{Brightness: colorModel.Brightness.ValueFloat32Pointer()}
+		}
+
+		colors = append(colors, paletteColor)
+	}
+
+	colorTemperatures := []openhue.ColorTemperaturePalettePost{}
+	for _, colorTemperatureModel := range paletteModel.ColorTemperatures {
+		colorTemperature := openhue.ColorTemperaturePalettePost{
+			ColorTemperature: &struct {
+				Mirek *openhue.Mirek `json:"mirek,omitempty"`
+			}{
+				Mirek: util.IntPointer(int(colorTemperatureModel.Mirek.ValueInt64())),
+			},
+		}
+
+		if !colorTemperatureModel.Brightness.IsNull() {
+			colorTemperature.Dimming = &openhue.Dimming{Brightness: colorTemperatureModel.Brightness.ValueFloat32Pointer()}
+		}
+
+		colorTemperatures = append(colorTemperatures, colorTemperature)
+	}
+
+	dimming := []openhue.Dimming{}
+	for _, brightness := range paletteModel.Brightness {
+		dimming = append(dimming, openhue.Dimming{Brightness: brightness.ValueFloat32Pointer()})
+	}
+
+	effects := []struct {
+		Effect *openhue.SupportedEffects `json:"effect,omitempty"`
+	}{}
+	for _, effectModel := range paletteModel.Effects {
+		effect := openhue.SupportedEffects(effectModel.ValueString())
+		effects = append(effects, struct {
+			Effect *openhue.SupportedEffects `json:"effect,omitempty"`
+		}{Effect: &effect})
+	}
+
+	// The bridge expects every list to be present, even when empty
+	return &openhue.ScenePalette{
+		Color:            &colors,
+		ColorTemperature: &colorTemperatures,
+		Dimming:          &dimming,
+		Effects:          &effects,
+	}, nil
+}
+
+func hexToColor(hexColor string) (*openhue.Color, error) {
+	x, y, _, err := util.HexToXyy(hexColor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid color %s: %w", hexColor, err)
+	}
+
+	return &openhue.Color{
+		Xy: &openhue.GamutPosition{
+			X: util.Float32Pointer(x),
+			Y: util.Float32Pointer(y),
+		},
+	}, nil
+}
+
+// mapColorToModel returns the hex color for an xy color, keeping the prior hex color if it still
+// describes the same color so that configured values do not drift through the xy conversion
+func mapColorToModel(prior types.String, color *openhue.Color) types.String {
+	if color == nil || color.Xy == nil || color.Xy.X == nil || color.Xy.Y == nil {
+		return types.StringNull()
+	}
+
+	if !prior.IsNull() && util.HexMatchesXy(prior.ValueString(), *color.Xy.X, *color.Xy.Y) {
+		return prior
+	}
+
+	return types.StringValue(util.XyToHex(*color.Xy.X, *color.Xy.Y))
+}
+
+func mapSceneToResourceModel(sceneModel sceneResourceModel, scene *openhue.SceneGet) sceneResourceModel {
+	model := sceneResourceModel{
+		Id:          types.StringPointerValue(scene.Id),
+		Name:        types.StringNull(),
+		GroupId:     types.StringNull(),
+		GroupType:   types.StringNull(),
+		Actions:     mapSceneActionsToModel(sceneModel.Actions, scene.Actions),
+		Palette:     sceneModel.Palette,
+		Speed:       types.Float32PointerValue(scene.Speed),
+		AutoDynamic: types.BoolPointerValue(scene.AutoDynamic),
+	}
+
+	if scene.Metadata != nil {
+		model.Name = types.StringPointerValue(scene.Metadata.Name)
+	}
+
+	if scene.Group != nil {
+		model.GroupId = types.StringPointerValue(scene.Group.Rid)
+		model.GroupType = types.StringPointerValue((*string)(scene.Group.Rtype))
+	}
+
+	if scene.Palette != nil {
+		model.Palette = mapScenePaletteToResourceModel(sceneModel.Palette, scene.Palette)
+	}
+
+	return model
+}
+
+// mapSceneActionsToModel reads back the actions of a scene in the order of the prior actions,
+// since the bridge does not keep them in the order they were written
+func mapSceneActionsToModel(priorActions []sceneResourceModelAction, actions *[]openhue.ActionGet) []sceneResourceModelAction {
+	if actions == nil {
+		return nil
+	}
+
+	priorByTarget := map[string]sceneResourceModelAction{}
+	order := map[string]int{}
+	for i, action := range priorActions {
+		priorByTarget[action.TargetId.ValueString()] = action
+		order[action.TargetId.ValueString()] = i
+	}
+
+	ordered := make([]sceneResourceModelAction, len(priorActions))
+	found := make([]bool, len(priorActions))
+	extra := []sceneResourceModelAction{}
+
+	for _, action := range *actions {
+		if action.Target == nil || action.Target.Rid == nil {
+			continue
+		}
+
+		prior, ok := priorByTarget[*action.Target.Rid]
+		if !ok {
+			prior = sceneResourceModelAction{
+				On:               types.BoolNull(),
+				Brightness:       types.Float32Null(),
+				Color:            types.StringNull(),
+				ColorTemperature: types.Int64Null(),
+				Effect:           types.StringNull(),
+			}
+		}
+
+		actionModel := mapSceneActionToResourceModel(prior, &action)
+
+		if i, ok := order[*action.Target.Rid]; ok {
+			ordered[i] = actionModel
+			found[i] = true
+			continue
+		}
+
+		extra = append(extra, actionModel)
+	}
+
+	// Actions removed outside of Terraform are dropped so the plan puts them back
+	result := []sceneResourceModelAction{}
+	for i, action := range ordered {
+		if found[i] {
+			result = append(result, action)
+		}
+	}
+
+	return append(result, extra...)
+}
+
+// mapSceneActionToResourceModel reads back the parts of an action that are managed, or all of them
+// for actions that are not in the prior state, for example after an import
+func mapSceneActionToResourceModel(prior sceneResourceModelAction, action *openhue.ActionGet) sceneResourceModelAction {
+	isNew := prior.TargetId.IsNull()
+
+	model := sceneResourceModelAction{
+		TargetId:         types.StringPointerValue(action.Target.Rid),
+		On:               types.BoolNull(),
+		Brightness:       types.Float32Null(),
+		Color:            types.StringNull(),
+		ColorTemperature: types.Int64Null(),
+		Effect:           types.StringNull(),
+	}
+
+	if action.Action == nil {
+		return model
+	}
+
+	if action.Action.On != nil && (isNew || !prior.On.IsNull()) {
+		model.On = types.BoolPointerValue(action.Action.On.On)
+	}
+
+	if action.Action.Dimming != nil && (isNew || !prior.Brightness.IsNull()) {
+		model.Brightness = types.Float32PointerValue(action.Action.Dimming.Brightness)
+	}
+
+	if action.Action.Color != nil && (isNew || !prior.Color.IsNull()) {
+		model.Color = mapColorToModel(prior.Color, action.Action.Color)
+	}
+
+	if action.Action.ColorTemperature != nil && action.Action.ColorTemperature.Mirek != nil && (isNew || !prior.ColorTemperature.IsNull()) {
+		model.ColorTemperature = types.Int64Value(int64(*action.Action.ColorTemperature.Mirek))
+	}
+
+	if action.Action.Effects != nil && action.Action.Effects.Effect != nil && (isNew || !prior.Effect.IsNull()) {
+		model.Effect = types.StringValue(string(*action.Action.Effects.Effect))
+	}
+
+	return model
+}
+
+// mapScenePaletteToResourceModel reads back the palette, using the prior palette only to keep the
+// formatting of colors and which brightnesses are managed. An empty palette is read back as null.
+func mapScenePaletteToResourceModel(priorPalette *sceneResourceModelPalette, palette *openhue.ScenePalette) *sceneResourceModelPalette {
+	if priorPalette == nil {
+		priorPalette = &sceneResourceModelPalette{}
+	}
+
+	model := &sceneResourceModelPalette{}
+
+	if palette.Color != nil && len(*palette.Color) > 0 {
+		for i, color := range *palette.Color {
+			prior := sceneResourceModelPaletteColor{
+				Color:      types.StringNull(),
+				Brightness: types.Float32Null(),
+			}
+			if i < len(priorPalette.Colors) {
+				prior = priorPalette.Colors[i]
+			}
+
+			colorModel := sceneResourceModelPaletteColor{
+				Color:      mapColorToModel(prior.Color, color.Color),
+				Brightness: types.Float32Null(),
+			}
+
+			// Colors without a brightness are reported with one, which is only read back when it is managed
+			if color.Dimming != nil && (i >= len(priorPalette.Colors) || !prior.Brightness.IsNull()) {
+				colorModel.Brightness = types.Float32PointerValue(color.Dimming.Brightness)
+			}
+
+			model.Colors = append(model.Colors, colorModel)
+		}
+	}
+
+	if palette.ColorTemperature != nil && len(*palette.ColorTemperature) > 0 {
+		for i, colorTemperature := range *palette.ColorTemperature {
+			colorTemperatureModel := sceneResourceModelPaletteColorTemperature{
+				Mirek:      types.Int64Null(),
+				Brightness: types.Float32Null(),
+			}
+
+			if colorTemperature.ColorTemperature != nil && colorTemperature.ColorTemperature.Mirek != nil {
+				colorTemperatureModel.Mirek = types.Int64Value(int64(*colorTemperature.ColorTemperature.Mirek))
+			}
+
+			if colorTemperature.Dimming != nil && (i >= len(priorPalette.ColorTemperatures) || !priorPalette.ColorTemperatures[i].Brightness.IsNull()) {
+				colorTemperatureModel.Brightness = types.Float32PointerValue(colorTemperature.Dimming.Brightness)
+			}
+
+			model.ColorTemperatures = append(model.ColorTemperatures, colorTemperatureModel)
+		}
+	}
+
+	if palette.Dimming != nil && len(*palette.Dimming) > 0 {
+		for _, dimming := range *palette.Dimming {
+			model.Brightness = append(model.Brightness, types.Float32PointerValue(dimming.Brightness))
+		}
+	}
+
+	if palette.Effects != nil && len(*palette.Effects) > 0 {
+		for _, effect := range *palette.Effects {
+			if effect.Effect != nil {
+				model.Effects = append(model.Effects, types.StringValue(string(*effect.Effect)))
+			}
+		}
+	}
+
+	if len(model.Colors) == 0 && len(model.ColorTemperatures) == 0 && len(model.Brightness) == 0 && len(model.Effects) == 0 {
+		return nil
+	}
+
+	return model
+}
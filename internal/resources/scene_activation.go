@@ -0,0 +1,287 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openhue/openhue-go"
+	"github.com/ryanolee/terraform-provider-talk/internal/hue"
+	"github.com/ryanolee/terraform-provider-talk/internal/util"
+)
+
+type (
+	SceneActivation struct {
+		client *hue.Client
+	}
+
+	sceneActivationResourceModel struct {
+		Id         types.String  `tfsdk:"id"`
+		SceneId    types.String  `tfsdk:"scene_id"`
+		Action     types.String  `tfsdk:"action"`
+		Duration   types.Int64   `tfsdk:"duration"`
+		Brightness types.Float32 `tfsdk:"brightness"`
+		Triggers   types.Map     `tfsdk:"triggers"`
+		Active     types.Bool    `tfsdk:"active"`
+		Status     types.String  `tfsdk:"status"`
+	}
+)
+
+func NewSceneActivation() resource.Resource {
+	return &SceneActivation{}
+}
+
+func (r *SceneActivation) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_scene_activation", req.ProviderTypeName)
+}
+
+func (r *SceneActivation) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Configure can be called multiple times (sometimes without provider data)
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*hue.Client)
+	if !ok {
+		resp.Diagnostics.AddError("expected hue.Client", fmt.Sprintf("Expected *hue.Client, got %T", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SceneActivation) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the scene that was recalled",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"scene_id": schema.StringAttribute{
+				Description: "The ID of the scene to recall",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"action": schema.StringAttribute{
+				Description: "How the scene is recalled. `active` applies the scene's actions, `dynamic_palette` starts cycling through the scene's palette and `static` applies the scene without dynamics. Defaults to `active`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(string(openhue.SceneRecallActionActive)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(openhue.SceneRecallActionActive),
+						string(openhue.SceneRecallActionDynamicPalette),
+						string(openhue.SceneRecallActionStatic),
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"duration": schema.Int64Attribute{
+				Description: "How long in milliseconds the transition into the scene takes",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"brightness": schema.Float32Attribute{
+				Description: "The brightness to recall the scene at in percent, overriding the brightness stored in the scene",
+				Optional:    true,
+				Validators: []validator.Float32{
+					float32validator.Between(0, 100),
+				},
+				PlanModifiers: []planmodifier.Float32{
+					float32planmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary values that cause the scene to be recalled again whenever they change",
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the scene is still active, as reported by the bridge",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "The activation status reported by the bridge, one of `inactive`, `static` or `dynamic_palette`",
+				Computed:    true,
+			},
+		},
+		Description: "Recalls a scene, for example to put a room into a known state after changing its lighting configuration. The scene is recalled on create and whenever `triggers` change.",
+	}
+}
+
+func (r *SceneActivation) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to recall scene", "client is nil")
+		return
+	}
+
+	var model sceneActivationResourceModel
+
+	resp.Diagnostics.Append(
+		req.Plan.Get(ctx, &model)...,
+	)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Recalling scene %s using %s", model.SceneId.String(), model.Action.String()))
+
+	action := openhue.SceneRecallAction(model.Action.ValueString())
+	recall := openhue.SceneRecall{
+		Action: &action,
+	}
+
+	if !model.Duration.IsNull() {
+		recall.Duration = util.IntPointer(int(model.Duration.ValueInt64()))
+	}
+
+	if !model.Brightness.IsNull() {
+		recall.Dimming = &openhue.Dimming{
+			Brightness: model.Brightness.ValueFloat32Pointer(),
+		}
+	}
+
+	apiResp, err := r.client.UpdateSceneWithResponse(ctx, model.SceneId.ValueString(), openhue.ScenePut{
+		Recall: &recall,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("failed to recall scene", fmt.Sprintf("failed to recall scene: %s", err.Error()))
+		return
+	}
+
+	if apiResp.HTTPResponse.StatusCode != http.StatusOK {
+		resp.Diagnostics.AddError("failed to recall scene", fmt.Sprintf("failed to recall scene: %s, %s", apiResp.HTTPResponse.Status, string(apiResp.Body)))
+		return
+	}
+
+	model.Id = model.SceneId
+
+	status, err := r.getSceneStatus(ctx, model.SceneId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get scene", fmt.Sprintf("failed to get scene: %s", err.Error()))
+		return
+	}
+
+	model.Status = types.StringValue(status)
+	model.Active = types.BoolValue(status != string(openhue.SceneGetStatusActiveInactive))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *SceneActivation) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to read scene activation", "client is nil")
+		return
+	}
+
+	var model sceneActivationResourceModel
+
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &model)...,
+	)
+
+	tflog.Info(ctx, fmt.Sprintf("Reading scene activation %s", model.SceneId.String()))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.client.GetSceneWithResponse(ctx, model.SceneId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get scene", fmt.Sprintf("failed to get scene: %s", err.Error()))
+		return
+	}
+
+	// The scene is gone, so there is nothing left to be active
+	if apiResp.HTTPResponse.StatusCode == http.StatusNotFound {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	status, err := sceneStatusFromResponse(apiResp)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get scene", fmt.Sprintf("failed to get scene: %s", err.Error()))
+		return
+	}
+
+	model.Status = types.StringValue(status)
+	model.Active = types.BoolValue(status != string(openhue.SceneGetStatusActiveInactive))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *SceneActivation) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var model sceneActivationResourceModel
+
+	resp.Diagnostics.Append(
+		req.Plan.Get(ctx, &model)...,
+	)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *SceneActivation) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// This is a no-op because recalling a scene leaves nothing behind to delete
+	return
+}
+
+// getSceneStatus returns the activation status the bridge reports for the scene
+func (r *SceneActivation) getSceneStatus(ctx context.Context, sceneId string) (string, error) {
+	apiResp, err := r.client.GetSceneWithResponse(ctx, sceneId)
+	if err != nil {
+		return "", err
+	}
+
+	return sceneStatusFromResponse(apiResp)
+}
+
+func sceneStatusFromResponse(apiResp *openhue.GetSceneResponse) (string, error) {
+	if apiResp.HTTPResponse.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("%s, %s", apiResp.HTTPResponse.Status, string(apiResp.Body))
+	}
+
+	if apiResp.JSON200 == nil || apiResp.JSON200.Data == nil || len(*apiResp.JSON200.Data) == 0 {
+		return "", fmt.Errorf("no data in response body")
+	}
+
+	scene := (*apiResp.JSON200.Data)[0]
+	if scene.Status == nil || scene.Status.Active == nil {
+		return string(openhue.SceneGetStatusActiveInactive), nil
+	}
+
+	return string(*scene.Status.Active), nil
+}
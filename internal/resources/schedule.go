@@ -0,0 +1,357 @@
+package resources
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/ryanolee/terraform-provider-talk/internal/hue"
+	"github.com/ryanolee/terraform-provider-talk/internal/util"
+)
+
+type (
+	Schedule struct {
+		client *hue.Client
+	}
+
+	scheduleResourceModel struct {
+		Id             types.String `tfsdk:"id"`
+		Name           types.String `tfsdk:"name"`
+		Description    types.String `tfsdk:"description"`
+		CommandAddress types.String `tfsdk:"command_address"`
+		CommandMethod  types.String `tfsdk:"command_method"`
+		CommandBody    types.String `tfsdk:"command_body"`
+		Localtime      types.String `tfsdk:"localtime"`
+		Status         types.String `tfsdk:"status"`
+		Autodelete     types.Bool   `tfsdk:"autodelete"`
+	}
+)
+
+// scheduleTimePattern is a time of day as used by the v1 API, for example 07:30:00
+const scheduleTimePattern = `([01][0-9]|2[0-3]):[0-5][0-9]:[0-5][0-9]`
+
+// scheduleLocaltimeRegex matches the v1 localtime patterns: absolute times, recurring weekday times,
+// timers and recurring timers, each optionally randomized with A followed by the maximum offset
+var scheduleLocaltimeRegex = regexp.MustCompile(
+	`^(` +
+		`[0-9]{4}-(0[1-9]|1[0-2])-(0[1-9]|[12][0-9]|3[01])T` + scheduleTimePattern +
+		`|W(12[0-7]|1[01][0-9]|[1-9][0-9]?)/T` + scheduleTimePattern +
+		`|(R([0-9]{2})?/)?PT` + scheduleTimePattern +
+		`)(A` + scheduleTimePattern + `)?$`,
+)
+
+func NewSchedule() resource.Resource {
+	return &Schedule{}
+}
+
+func (r *Schedule) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_schedule", req.ProviderTypeName)
+}
+
+func (r *Schedule) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Configure can be called multiple times (sometimes without provider data)
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*hue.Client)
+	if !ok {
+		resp.Diagnostics.AddError("expected hue.Client", fmt.Sprintf("Expected *hue.Client, got %T", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *Schedule) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The v1 ID of the schedule",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the schedule",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 32),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "A description of the schedule",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(64),
+				},
+			},
+			"command_address": schema.StringAttribute{
+				Description: "The v1 address the command is sent to, without the `/api/<key>` prefix. For example `/groups/1/action` or `/lights/3/state`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^/`), "must start with /"),
+				},
+			},
+			"command_method": schema.StringAttribute{
+				Description: "The HTTP method of the command, one of `PUT`, `POST` or `DELETE`. Defaults to `PUT`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(http.MethodPut),
+				Validators: []validator.String{
+					stringvalidator.OneOf(http.MethodPut, http.MethodPost, http.MethodDelete),
+				},
+			},
+			"command_body": schema.StringAttribute{
+				Description: "The JSON body of the command, for example `jsonencode({ on = true })`",
+				Required:    true,
+			},
+			"localtime": schema.StringAttribute{
+				Description: "When the schedule fires, in local time. One of `YYYY-MM-DDThh:mm:ss` for a single time, `W[bbb]/Thh:mm:ss` for a recurring time where `bbb` is a weekday bitmask (64 for Monday down to 1 for Sunday), `PThh:mm:ss` for a timer or `R[nn]/PThh:mm:ss` for a timer repeating `nn` times (forever if left out). Any of them can be randomized by appending `Ahh:mm:ss`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(scheduleLocaltimeRegex, "must be a v1 localtime such as 2024-12-24T18:00:00, W124/T07:00:00, PT00:10:00 or R/PT01:00:00, optionally followed by A and a random offset such as A00:15:00"),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: "Whether the schedule is `enabled` or `disabled`. Defaults to `enabled`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(hue.ScheduleStatusEnabled),
+				Validators: []validator.String{
+					stringvalidator.OneOf(hue.ScheduleStatusEnabled, hue.ScheduleStatusDisabled),
+				},
+			},
+			"autodelete": schema.BoolAttribute{
+				Description: "Whether the bridge deletes the schedule once it has fired. Only applies to schedules that do not recur. Left as chosen by the bridge if not set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Description: "A schedule on the legacy v1 API, which sends a command to the bridge at a given time. Use it for timed on and off, which CLIP v2 does not offer. Schedules that delete themselves after firing are planned to be created again.",
+	}
+}
+
+func (r *Schedule) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var commandBody types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("command_body"), &commandBody)...)
+
+	if resp.Diagnostics.HasError() || commandBody.IsNull() || commandBody.IsUnknown() {
+		return
+	}
+
+	if !json.Valid([]byte(commandBody.ValueString())) {
+		resp.Diagnostics.AddAttributeError(path.Root("command_body"), "invalid command body", "command_body must be valid JSON")
+	}
+}
+
+func (r *Schedule) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to create schedule", "client is nil")
+		return
+	}
+
+	var model scheduleResourceModel
+
+	resp.Diagnostics.Append(
+		req.Plan.Get(ctx, &model)...,
+	)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Creating schedule %s", model.Name.String()))
+
+	id, err := r.client.V1.CreateSchedule(ctx, r.scheduleModelToPayload(&model))
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create schedule", fmt.Sprintf("failed to create schedule: %s", err.Error()))
+		return
+	}
+
+	model.Id = types.StringValue(id)
+
+	if model.Autodelete.IsUnknown() {
+		schedule, err := r.client.V1.GetSchedule(ctx, id)
+		if err != nil {
+			resp.Diagnostics.AddError("failed to get schedule", fmt.Sprintf("failed to get schedule: %s", err.Error()))
+			return
+		}
+
+		model.Autodelete = types.BoolPointerValue(schedule.Autodelete)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *Schedule) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to read schedule", "client is nil")
+		return
+	}
+
+	var model scheduleResourceModel
+
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &model)...,
+	)
+
+	tflog.Info(ctx, fmt.Sprintf("Reading schedule %s", model.Id.String()))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	schedule, err := r.client.V1.GetSchedule(ctx, model.Id.ValueString())
+
+	// Schedules that have fired and deleted themselves are created again on the next apply
+	if hue.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get schedule", fmt.Sprintf("failed to get schedule: %s", err.Error()))
+		return
+	}
+
+	model = r.mapScheduleToModel(model, schedule)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *Schedule) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to update schedule", "client is nil")
+		return
+	}
+
+	var model scheduleResourceModel
+
+	resp.Diagnostics.Append(
+		req.Plan.Get(ctx, &model)...,
+	)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updating schedule %s", model.Id.String()))
+
+	if err := r.client.V1.UpdateSchedule(ctx, model.Id.ValueString(), r.scheduleModelToPayload(&model)); err != nil {
+		resp.Diagnostics.AddError("failed to update schedule", fmt.Sprintf("failed to update schedule: %s", err.Error()))
+		return
+	}
+
+	// The prior state has no autodelete to carry over when the bridge did not report one, for example
+	// for recurring schedules
+	if model.Autodelete.IsUnknown() {
+		schedule, err := r.client.V1.GetSchedule(ctx, model.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("failed to get schedule", fmt.Sprintf("failed to get schedule: %s", err.Error()))
+			return
+		}
+
+		model.Autodelete = types.BoolPointerValue(schedule.Autodelete)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *Schedule) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to delete schedule", "client is nil")
+		return
+	}
+
+	var model scheduleResourceModel
+
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &model)...,
+	)
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting schedule %s", model.Id.String()))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.V1.DeleteSchedule(ctx, model.Id.ValueString()); err != nil && !hue.IsNotFound(err) {
+		resp.Diagnostics.AddError("failed to delete schedule", fmt.Sprintf("failed to delete schedule: %s", err.Error()))
+		return
+	}
+}
+
+func (r *Schedule) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *Schedule) scheduleModelToPayload(model *scheduleResourceModel) hue.Schedule {
+	// An empty description clears one that was removed from the configuration
+	description := model.Description.ValueString()
+
+	schedule := hue.Schedule{
+		Name:        model.Name.ValueStringPointer(),
+		Description: &description,
+		Command: &hue.ScheduleCommand{
+			Address: r.client.V1.Address(model.CommandAddress.ValueString()),
+			Method:  model.CommandMethod.ValueString(),
+			Body:    json.RawMessage(model.CommandBody.ValueString()),
+		},
+		Localtime: model.Localtime.ValueStringPointer(),
+		Status:    model.Status.ValueStringPointer(),
+	}
+
+	if !model.Autodelete.IsNull() && !model.Autodelete.IsUnknown() {
+		schedule.Autodelete = model.Autodelete.ValueBoolPointer()
+	}
+
+	return schedule
+}
+
+func (r *Schedule) mapScheduleToModel(scheduleModel scheduleResourceModel, schedule *hue.Schedule) scheduleResourceModel {
+	model := scheduleResourceModel{
+		Id:          scheduleModel.Id,
+		Name:        types.StringPointerValue(schedule.Name),
+		Description: scheduleModel.Description,
+		Localtime:   types.StringPointerValue(schedule.Localtime),
+		Status:      types.StringPointerValue(schedule.Status),
+		Autodelete:  types.BoolPointerValue(schedule.Autodelete),
+	}
+
+	// The bridge reports an empty description for schedules created without one
+	if schedule.Description != nil && (*schedule.Description != "" || !scheduleModel.Description.IsNull()) {
+		model.Description = types.StringPointerValue(schedule.Description)
+	}
+
+	if schedule.Command != nil {
+		model.CommandAddress = types.StringValue(r.client.V1.ResourcePath(schedule.Command.Address))
+		model.CommandMethod = types.StringValue(schedule.Command.Method)
+		model.CommandBody = types.StringValue(string(schedule.Command.Body))
+
+		// Keep the configured formatting of the body as long as it holds the same value
+		if !scheduleModel.CommandBody.IsNull() && util.JsonEqual(scheduleModel.CommandBody.ValueString(), string(schedule.Command.Body)) {
+			model.CommandBody = scheduleModel.CommandBody
+		}
+	}
+
+	return model
+}
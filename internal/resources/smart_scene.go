@@ -0,0 +1,557 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/openhue/openhue-go"
+	"github.com/ryanolee/terraform-provider-talk/internal/hue"
+	"github.com/ryanolee/terraform-provider-talk/internal/util"
+)
+
+type (
+	SmartScene struct {
+		client *hue.Client
+	}
+
+	smartSceneResourceModel struct {
+		Id                 types.String                          `tfsdk:"id"`
+		Name               types.String                          `tfsdk:"name"`
+		GroupId            types.String                          `tfsdk:"group_id"`
+		GroupType          types.String                          `tfsdk:"group_type"`
+		WeekTimeslots      []smartSceneResourceModelWeekTimeslot `tfsdk:"week_timeslots"`
+		TransitionDuration types.Int64                           `tfsdk:"transition_duration"`
+		Active             types.Bool                            `tfsdk:"active"`
+	}
+
+	smartSceneResourceModelWeekTimeslot struct {
+		Recurrence []types.String                    `tfsdk:"recurrence"`
+		Timeslots  []smartSceneResourceModelTimeslot `tfsdk:"timeslots"`
+	}
+
+	smartSceneResourceModelTimeslot struct {
+		StartKind types.String `tfsdk:"start_kind"`
+		StartTime types.String `tfsdk:"start_time"`
+		SceneId   types.String `tfsdk:"scene_id"`
+	}
+)
+
+var (
+	smartSceneWeekdays = []string{"monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"}
+
+	smartSceneStartTimeRegex = regexp.MustCompile(`^([01][0-9]|2[0-3]):[0-5][0-9](:[0-5][0-9])?$`)
+)
+
+func NewSmartScene() resource.Resource {
+	return &SmartScene{}
+}
+
+func (r *SmartScene) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_smart_scene", req.ProviderTypeName)
+}
+
+func (r *SmartScene) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Configure can be called multiple times (sometimes without provider data)
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*hue.Client)
+	if !ok {
+		resp.Diagnostics.AddError("expected hue.Client", fmt.Sprintf("Expected *hue.Client, got %T", req.ProviderData))
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SmartScene) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the smart scene",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "The name of the smart scene",
+				Required:    true,
+			},
+			"group_id": schema.StringAttribute{
+				Description: "The ID of the room or zone the smart scene controls",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group_type": schema.StringAttribute{
+				Description: "The type of group `group_id` refers to, either `room` or `zone`. Defaults to `room`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(string(openhue.ResourceIdentifierRtypeRoom)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(openhue.ResourceIdentifierRtypeRoom), string(openhue.ResourceIdentifierRtypeZone)),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"week_timeslots": schema.ListNestedAttribute{
+				Description: "The timeslots of the smart scene, grouped by the weekdays they apply to",
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"recurrence": schema.ListAttribute{
+							Description: "The weekdays these timeslots apply to, for example `monday`",
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.UniqueValues(),
+								listvalidator.ValueStringsAre(stringvalidator.OneOf(smartSceneWeekdays...)),
+							},
+						},
+						"timeslots": schema.ListNestedAttribute{
+							Description: "The scenes to recall over the day, in order of their start",
+							Required:    true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"start_kind": schema.StringAttribute{
+										Description: "What starts the timeslot, either a fixed `time` or `sunset`. Defaults to `time`. The bridge does not support starting timeslots at sunrise.",
+										Optional:    true,
+										Computed:    true,
+										Default:     stringdefault.StaticString(hue.SmartSceneStartKindTime),
+										Validators: []validator.String{
+											stringvalidator.OneOf(hue.SmartSceneStartKindTime, hue.SmartSceneStartKindSunset),
+										},
+									},
+									"start_time": schema.StringAttribute{
+										Description: "The time of day the timeslot starts as `HH:MM` or `HH:MM:SS`. Required when `start_kind` is `time`.",
+										Optional:    true,
+										Validators: []validator.String{
+											stringvalidator.RegexMatches(smartSceneStartTimeRegex, "must be a time of day such as 07:30"),
+										},
+									},
+									"scene_id": schema.StringAttribute{
+										Description: "The ID of the scene to recall during the timeslot",
+										Required:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"transition_duration": schema.Int64Attribute{
+				Description: "How long in milliseconds the transition between timeslots takes. Left as chosen by the bridge if not set.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Description: "Whether the smart scene is active. Left as is if not set.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Description: "A smart scene, which recalls a different scene of a room or zone depending on the weekday and time of day",
+	}
+}
+
+func (r *SmartScene) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model smartSceneResourceModel
+
+	// The timeslots cannot be checked until they are known, for example while they come from another resource
+	if diags := req.Config.Get(ctx, &model); diags.HasError() {
+		return
+	}
+
+	for i, weekTimeslot := range model.WeekTimeslots {
+		for j, timeslot := range weekTimeslot.Timeslots {
+			timeslotPath := path.Root("week_timeslots").AtListIndex(i).AtName("timeslots").AtListIndex(j)
+
+			// A null kind falls back to the default of time
+			isTime := timeslot.StartKind.IsNull() || timeslot.StartKind.ValueString() == hue.SmartSceneStartKindTime
+
+			if isTime && timeslot.StartTime.IsNull() {
+				resp.Diagnostics.AddAttributeError(timeslotPath.AtName("start_time"), "missing start time", "start_time must be set when start_kind is time")
+			}
+
+			if !timeslot.StartKind.IsUnknown() && !isTime && !timeslot.StartTime.IsNull() {
+				resp.Diagnostics.AddAttributeError(timeslotPath.AtName("start_time"), "unexpected start time", fmt.Sprintf("start_time can only be set when start_kind is time, got %s", timeslot.StartKind.String()))
+			}
+		}
+	}
+}
+
+func (r *SmartScene) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to create smart scene", "client is nil")
+		return
+	}
+
+	var model smartSceneResourceModel
+
+	resp.Diagnostics.Append(
+		req.Plan.Get(ctx, &model)...,
+	)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Creating smart scene %s", model.Name.String()))
+
+	payload, err := smartSceneModelToPayload(&model)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create smart scene", fmt.Sprintf("failed to create smart scene: %s", err.Error()))
+		return
+	}
+
+	payload.Group = &openhue.ResourceIdentifier{
+		Rid:   model.GroupId.ValueStringPointer(),
+		Rtype: (*openhue.ResourceIdentifierRtype)(model.GroupType.ValueStringPointer()),
+	}
+
+	id, err := r.client.CreateSmartScene(ctx, payload)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to create smart scene", fmt.Sprintf("failed to create smart scene: %s", err.Error()))
+		return
+	}
+
+	model.Id = types.StringValue(id)
+
+	// Save the ID straight away so a failure below does not leave the smart scene untracked
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), model.Id)...)
+
+	// Only recall the smart scene when the activation state is configured, not when it was carried over from state
+	var configuredActive types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("active"), &configuredActive)...)
+
+	r.applySmartScene(ctx, &model, configuredActive, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *SmartScene) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to read smart scene", "client is nil")
+		return
+	}
+
+	var model smartSceneResourceModel
+
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &model)...,
+	)
+
+	tflog.Info(ctx, fmt.Sprintf("Reading smart scene %s", model.Id.String()))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	smartScene, err := r.client.GetSmartScene(ctx, model.Id.ValueString())
+	if hue.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("failed to get smart scene", fmt.Sprintf("failed to get smart scene: %s", err.Error()))
+		return
+	}
+
+	model = mapSmartSceneToModel(model, smartScene)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *SmartScene) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to update smart scene", "client is nil")
+		return
+	}
+
+	var model smartSceneResourceModel
+
+	resp.Diagnostics.Append(
+		req.Plan.Get(ctx, &model)...,
+	)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Updating smart scene %s", model.Id.String()))
+
+	payload, err := smartSceneModelToPayload(&model)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to update smart scene", fmt.Sprintf("failed to update smart scene: %s", err.Error()))
+		return
+	}
+
+	if err := r.client.UpdateSmartScene(ctx, model.Id.ValueString(), payload); err != nil {
+		resp.Diagnostics.AddError("failed to update smart scene", fmt.Sprintf("failed to update smart scene: %s", err.Error()))
+		return
+	}
+
+	// Only recall the smart scene when the activation state is configured, not when it was carried over from state
+	var configuredActive types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("active"), &configuredActive)...)
+
+	r.applySmartScene(ctx, &model, configuredActive, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+func (r *SmartScene) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	if r.client == nil {
+		resp.Diagnostics.AddError("failed to delete smart scene", "client is nil")
+		return
+	}
+
+	var model smartSceneResourceModel
+
+	resp.Diagnostics.Append(
+		req.State.Get(ctx, &model)...,
+	)
+
+	tflog.Info(ctx, fmt.Sprintf("Deleting smart scene %s", model.Id.String()))
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteSmartScene(ctx, model.Id.ValueString()); err != nil && !hue.IsNotFound(err) {
+		resp.Diagnostics.AddError("failed to delete smart scene", fmt.Sprintf("failed to delete smart scene: %s", err.Error()))
+		return
+	}
+}
+
+func (r *SmartScene) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// applySmartScene recalls the smart scene into the configured activation state and fills in
+// the values left to the bridge
+func (r *SmartScene) applySmartScene(ctx context.Context, model *smartSceneResourceModel, active types.Bool, diags *diag.Diagnostics) {
+	if !active.IsNull() && !active.IsUnknown() {
+		action := hue.SmartSceneRecallDeactivate
+		if active.ValueBool() {
+			action = hue.SmartSceneRecallActivate
+		}
+
+		if err := r.client.RecallSmartScene(ctx, model.Id.ValueString(), action); err != nil {
+			diags.AddError("failed to recall smart scene", fmt.Sprintf("failed to recall smart scene: %s", err.Error()))
+			return
+		}
+	}
+
+	smartScene, err := r.client.GetSmartScene(ctx, model.Id.ValueString())
+	if err != nil {
+		diags.AddError("failed to get smart scene", fmt.Sprintf("failed to get smart scene: %s", err.Error()))
+		return
+	}
+
+	if model.TransitionDuration.IsUnknown() {
+		model.TransitionDuration = types.Int64Null()
+		if smartScene.TransitionDuration != nil {
+			model.TransitionDuration = types.Int64Value(int64(*smartScene.TransitionDuration))
+		}
+	}
+
+	if model.Active.IsUnknown() {
+		model.Active = types.BoolValue(smartScene.State != nil && *smartScene.State == hue.SmartSceneStateActive)
+	}
+}
+
+func smartSceneModelToPayload(model *smartSceneResourceModel) (hue.SmartScene, error) {
+	weekTimeslots := []hue.SmartSceneWeekTimeslot{}
+	for _, weekTimeslot := range model.WeekTimeslots {
+		recurrence := []string{}
+		for _, weekday := range weekTimeslot.Recurrence {
+			recurrence = append(recurrence, weekday.ValueString())
+		}
+
+		timeslots := []hue.SmartSceneTimeslot{}
+		for _, timeslot := range weekTimeslot.Timeslots {
+			startTime := hue.SmartSceneStartTime{
+				Kind: timeslot.StartKind.ValueString(),
+			}
+
+			if startTime.Kind == hue.SmartSceneStartKindTime {
+				parsed, err := parseSmartSceneTime(timeslot.StartTime.ValueString())
+				if err != nil {
+					return hue.SmartScene{}, err
+				}
+
+				startTime.Time = parsed
+			}
+
+			timeslots = append(timeslots, hue.SmartSceneTimeslot{
+				StartTime: startTime,
+				Target: openhue.ResourceIdentifier{
+					Rid:   timeslot.SceneId.ValueStringPointer(),
+					Rtype: (*openhue.ResourceIdentifierRtype)(util.StringPointer("scene")),
+				},
+			})
+		}
+
+		weekTimeslots = append(weekTimeslots, hue.SmartSceneWeekTimeslot{
+			Timeslots:  timeslots,
+			Recurrence: recurrence,
+		})
+	}
+
+	payload := hue.SmartScene{
+		Metadata: &hue.SmartSceneMetadata{
+			Name: model.Name.ValueStringPointer(),
+		},
+		WeekTimeslots: &weekTimeslots,
+	}
+
+	if !model.TransitionDuration.IsNull() && !model.TransitionDuration.IsUnknown() {
+		payload.TransitionDuration = util.IntPointer(int(model.TransitionDuration.ValueInt64()))
+	}
+
+	return payload, nil
+}
+
+func mapSmartSceneToModel(smartSceneModel smartSceneResourceModel, smartScene *hue.SmartScene) smartSceneResourceModel {
+	model := smartSceneResourceModel{
+		Id:                 types.StringPointerValue(smartScene.Id),
+		Name:               types.StringNull(),
+		GroupId:            types.StringNull(),
+		GroupType:          types.StringNull(),
+		TransitionDuration: types.Int64Null(),
+		Active:             types.BoolValue(smartScene.State != nil && *smartScene.State == hue.SmartSceneStateActive),
+	}
+
+	if smartScene.Metadata != nil {
+		model.Name = types.StringPointerValue(smartScene.Metadata.Name)
+	}
+
+	if smartScene.Group != nil {
+		model.GroupId = types.StringPointerValue(smartScene.Group.Rid)
+		model.GroupType = types.StringPointerValue((*string)(smartScene.Group.Rtype))
+	}
+
+	if smartScene.TransitionDuration != nil {
+		model.TransitionDuration = types.Int64Value(int64(*smartScene.TransitionDuration))
+	}
+
+	if smartScene.WeekTimeslots == nil {
+		return model
+	}
+
+	for i, weekTimeslot := range *smartScene.WeekTimeslots {
+		weekTimeslotModel := smartSceneResourceModelWeekTimeslot{}
+		for _, weekday := range weekTimeslot.Recurrence {
+			weekTimeslotModel.Recurrence = append(weekTimeslotModel.Recurrence, types.StringValue(weekday))
+		}
+
+		for j, timeslot := range weekTimeslot.Timeslots {
+			timeslotModel := smartSceneResourceModelTimeslot{
+				StartKind: types.StringValue(timeslot.StartTime.Kind),
+				StartTime: types.StringNull(),
+				SceneId:   types.StringPointerValue(timeslot.Target.Rid),
+			}
+
+			if timeslot.StartTime.Time != nil {
+				timeslotModel.StartTime = mapSmartSceneTimeToModel(
+					priorSmartSceneStartTime(smartSceneModel, i, j),
+					timeslot.StartTime.Time,
+				)
+			}
+
+			weekTimeslotModel.Timeslots = append(weekTimeslotModel.Timeslots, timeslotModel)
+		}
+
+		model.WeekTimeslots = append(model.WeekTimeslots, weekTimeslotModel)
+	}
+
+	return model
+}
+
+// priorSmartSceneStartTime returns the start time of the timeslot at the given position in the
+// model, or null if there is no such timeslot
+func priorSmartSceneStartTime(model smartSceneResourceModel, weekTimeslotIndex int, timeslotIndex int) types.String {
+	if weekTimeslotIndex >= len(model.WeekTimeslots) || timeslotIndex >= len(model.WeekTimeslots[weekTimeslotIndex].Timeslots) {
+		return types.StringNull()
+	}
+
+	return model.WeekTimeslots[weekTimeslotIndex].Timeslots[timeslotIndex].StartTime
+}
+
+// mapSmartSceneTimeToModel formats a start time, keeping the prior value if it is the same time
+// written differently (for example 07:00 rather than 07:00:00)
+func mapSmartSceneTimeToModel(prior types.String, startTime *hue.SmartSceneTime) types.String {
+	if !prior.IsNull() {
+		if parsed, err := parseSmartSceneTime(prior.ValueString()); err == nil && *parsed == *startTime {
+			return prior
+		}
+	}
+
+	if startTime.Second == 0 {
+		return types.StringValue(fmt.Sprintf("%02d:%02d", startTime.Hour, startTime.Minute))
+	}
+
+	return types.StringValue(fmt.Sprintf("%02d:%02d:%02d", startTime.Hour, startTime.Minute, startTime.Second))
+}
+
+// parseSmartSceneTime parses a time of day in the form HH:MM or HH:MM:SS
+func parseSmartSceneTime(value string) (*hue.SmartSceneTime, error) {
+	if !smartSceneStartTimeRegex.MatchString(value) {
+		return nil, fmt.Errorf("invalid start time \"%s\", expected HH:MM or HH:MM:SS", value)
+	}
+
+	parts := strings.Split(value, ":")
+	for len(parts) < 3 {
+		parts = append(parts, "00")
+	}
+
+	startTime := &hue.SmartSceneTime{}
+	fmt.Sscanf(strings.Join(parts, ":"), "%d:%d:%d", &startTime.Hour, &startTime.Minute, &startTime.Second)
+
+	return startTime, nil
+}
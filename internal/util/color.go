@@ -0,0 +1,40 @@
+package util
+
+import (
+	"math"
+
+	"github.com/lucasb-eyer/go-colorful"
+)
+
+// HexToXyy converts a hex color (for example "#ff0000") to the CIE xyY color space used by Hue lights.
+// x and y are the chromaticity coordinates and luminance is the Y component.
+func HexToXyy(hexColor string) (x float32, y float32, luminance float32, err error) {
+	color, err := colorful.Hex(hexColor)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	colorX, colorY, colorLuminance := color.Xyy()
+
+	return float32(colorX), float32(colorY), float32(colorLuminance), nil
+}
+
+// XyToHex converts CIE xy chromaticity coordinates to a hex color. The brightness of the color is not
+// part of xy, so the color is returned at full luminance, clamped to the sRGB gamut.
+func XyToHex(x float32, y float32) string {
+	return colorful.Xyy(float64(x), float64(y), 1).Clamped().Hex()
+}
+
+// HexMatchesXy reports whether a hex color converts to the given xy coordinates, allowing for the
+// precision the bridge stores them at
+func HexMatchesXy(hexColor string, x float32, y float32) bool {
+	hexX, hexY, _, err := HexToXyy(hexColor)
+	if err != nil {
+		return false
+	}
+
+	return math.Abs(float64(hexX-x)) < xyTolerance && math.Abs(float64(hexY-y)) < xyTolerance
+}
+
+// xyTolerance is how far apart two xy coordinates can be while still referring to the same color
+const xyTolerance = 0.001
@@ -0,0 +1,45 @@
+package util
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// JsonEqual reports whether two JSON documents hold the same value, ignoring formatting and key order.
+// Invalid documents are never equal.
+func JsonEqual(a string, b string) bool {
+	var valueA, valueB any
+
+	if err := json.Unmarshal([]byte(a), &valueA); err != nil {
+		return false
+	}
+
+	if err := json.Unmarshal([]byte(b), &valueB); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(valueA, valueB)
+}
+
+// JsonObjectSubset reports whether every key of the JSON object subset is present in the JSON object
+// document with the same value. Keys only present in document are ignored.
+func JsonObjectSubset(subset string, document string) bool {
+	var subsetObject, documentObject map[string]any
+
+	if err := json.Unmarshal([]byte(subset), &subsetObject); err != nil || subsetObject == nil {
+		return false
+	}
+
+	if err := json.Unmarshal([]byte(document), &documentObject); err != nil || documentObject == nil {
+		return false
+	}
+
+	for key, value := range subsetObject {
+		documentValue, ok := documentObject[key]
+		if !ok || !reflect.DeepEqual(value, documentValue) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -7,3 +7,7 @@ func StringPointer(s string) *string {
 func Float32Pointer(f float32) *float32 {
 	return &f
 }
+
+func IntPointer(i int) *int {
+	return &i
+}
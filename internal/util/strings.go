@@ -0,0 +1,50 @@
+package util
+
+import (
+	"strings"
+)
+
+// ClosestMatch returns the candidate closest to target by edit distance, ignoring case.
+// Candidates that would need more edits than a third of the target's length (and at least two) are not
+// considered close, in which case ok is false.
+func ClosestMatch(target string, candidates []string) (match string, ok bool) {
+	maxDistance := max(2, len(target)/3)
+	bestDistance := maxDistance + 1
+
+	for _, candidate := range candidates {
+		distance := levenshtein(strings.ToLower(target), strings.ToLower(candidate))
+		if distance < bestDistance {
+			match = candidate
+			bestDistance = distance
+		}
+	}
+
+	return match, bestDistance <= maxDistance
+}
+
+// levenshtein returns the number of single rune insertions, deletions and substitutions needed to turn a into b
+func levenshtein(a string, b string) int {
+	runesA, runesB := []rune(a), []rune(b)
+
+	previous := make([]int, len(runesB)+1)
+	current := make([]int, len(runesB)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(runesA); i++ {
+		current[0] = i
+		for j := 1; j <= len(runesB); j++ {
+			cost := 1
+			if runesA[i-1] == runesB[j-1] {
+				cost = 0
+			}
+
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(runesB)]
+}
@@ -3,12 +3,23 @@ package main
 import (
 	"context"
 	"log"
+	"os"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/ryanolee/terraform-provider-talk/internal/export"
 	"github.com/ryanolee/terraform-provider-talk/internal/provider"
 )
 
 func main() {
+	// Running the binary as "terraform-provider-openhue export" writes configuration for an existing bridge
+	if len(os.Args) > 1 && os.Args[1] == "export" {
+		if err := export.Run(context.Background(), os.Args[2:], os.Stdout, os.Stderr); err != nil {
+			log.Fatal(err.Error())
+		}
+
+		return
+	}
+
 	opts := providerserver.ServeOpts{
 		// TODO: Update this string with the namespace of your provider
 		Address: "registry.terraform.io/ryanolee/openhue",